@@ -0,0 +1,31 @@
+package bobotel
+
+import (
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// StringAttr is a helper function that returns a string attribute with the given key and value, e.g. for use with
+// SetAttributes without importing the open-telemetry attribute package.
+func StringAttr(key, value string) attribute.KeyValue {
+	return attribute.String(key, value)
+}
+
+// IntAttr is a helper function that returns an int attribute with the given key and value.
+func IntAttr(key string, value int) attribute.KeyValue {
+	return attribute.Int(key, value)
+}
+
+// BoolAttr is a helper function that returns a bool attribute with the given key and value.
+func BoolAttr(key string, value bool) attribute.KeyValue {
+	return attribute.Bool(key, value)
+}
+
+// Float64Attr is a helper function that returns a float64 attribute with the given key and value.
+func Float64Attr(key string, value float64) attribute.KeyValue {
+	return attribute.Float64(key, value)
+}
+
+// StringSliceAttr is a helper function that returns a string slice attribute with the given key and values.
+func StringSliceAttr(key string, values []string) attribute.KeyValue {
+	return attribute.StringSlice(key, values)
+}
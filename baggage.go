@@ -0,0 +1,30 @@
+package bobotel
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel/baggage"
+)
+
+// SetBaggage returns a copy of the given context with the given baggage member added, replacing any existing member
+// with the same key. The given context is returned unmodified alongside an error if the key or value is invalid.
+func SetBaggage(ctx context.Context, key, value string) (context.Context, error) {
+	member, err := baggage.NewMemberRaw(key, value)
+	if err != nil {
+		return ctx, fmt.Errorf("problem creating baggage member '%s': %w", key, err)
+	}
+
+	bag, err := baggage.FromContext(ctx).SetMember(member)
+	if err != nil {
+		return ctx, fmt.Errorf("problem setting baggage member '%s': %w", key, err)
+	}
+
+	return baggage.ContextWithBaggage(ctx, bag), nil
+}
+
+// GetBaggage returns the value of the baggage member with the given key from the given context. An empty string is
+// returned if no such member exists.
+func GetBaggage(ctx context.Context, key string) string {
+	return baggage.FromContext(ctx).Member(key).Value()
+}
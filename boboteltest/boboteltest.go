@@ -0,0 +1,103 @@
+// Package boboteltest provides helpers for asserting on the spans emitted by code traced with bobotel.
+package boboteltest
+
+import (
+	"testing"
+
+	"github.com/xavi-group/bobotel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+// SetupTestProvider initializes the bobotel trace provider with the 'memory' exporter, and returns the in-memory
+// exporter that the emitted spans are recorded by. Spans are exported synchronously, so they can be asserted on as
+// soon as they are ended. The package is reset via bobotel.Reset when the test completes, so that the trace provider
+// can be initialized again. Spans started but not ended when the test completes fail the test, e.g. for catching
+// missing span.End() calls. As the trace provider is a singleton, tests using SetupTestProvider must not run in
+// parallel.
+func SetupTestProvider(t testing.TB) *bobotel.InMemoryExporter {
+	t.Helper()
+
+	config := &bobotel.Config{
+		AppName:              t.Name(),
+		AppID:                t.Name(),
+		OtelExporters:        []string{"memory"},
+		OtelSetGlobal:        true,
+		OtelTrackLeakedSpans: true,
+	}
+
+	if err := bobotel.InitializeTraceProvider(config); err != nil {
+		t.Fatalf("problem initializing test trace provider: %s", err)
+	}
+
+	t.Cleanup(func() {
+		if err := bobotel.Reset(); err != nil {
+			t.Errorf("problem shutting down test trace provider: %s", err)
+		}
+
+		if leakedSpans := bobotel.LeakedSpans(); len(leakedSpans) > 0 {
+			t.Errorf("expected all started spans to be ended, found spans not ended: %v", leakedSpans)
+		}
+	})
+
+	return bobotel.GetInMemoryExporter()
+}
+
+// FindSpan returns the first span with the given name recorded by the exporter, and whether a span was found.
+func FindSpan(exporter *bobotel.InMemoryExporter, name string) (tracetest.SpanStub, bool) {
+	for _, span := range exporter.GetRecordedSpans() {
+		if span.Name == name {
+			return span, true
+		}
+	}
+
+	return tracetest.SpanStub{}, false
+}
+
+// AssertSpanExists fails the test if no span with the given name was recorded by the exporter, and otherwise returns
+// the first span with the given name.
+func AssertSpanExists(t testing.TB, exporter *bobotel.InMemoryExporter, name string) tracetest.SpanStub {
+	t.Helper()
+
+	span, found := FindSpan(exporter, name)
+	if !found {
+		t.Fatalf("expected a recorded span with name '%s', found spans: %v", name, spanNames(exporter))
+	}
+
+	return span
+}
+
+// AssertSpanNotExists fails the test if a span with the given name was recorded by the exporter.
+func AssertSpanNotExists(t testing.TB, exporter *bobotel.InMemoryExporter, name string) {
+	t.Helper()
+
+	if _, found := FindSpan(exporter, name); found {
+		t.Fatalf("expected no recorded span with name '%s'", name)
+	}
+}
+
+// SpanAttributes fails the test if no span with the given name was recorded by the exporter, and otherwise returns the
+// attributes of the first span with the given name.
+func SpanAttributes(t testing.TB, exporter *bobotel.InMemoryExporter, name string) map[attribute.Key]attribute.Value {
+	t.Helper()
+
+	span := AssertSpanExists(t, exporter, name)
+	attributes := make(map[attribute.Key]attribute.Value, len(span.Attributes))
+
+	for _, attr := range span.Attributes {
+		attributes[attr.Key] = attr.Value
+	}
+
+	return attributes
+}
+
+func spanNames(exporter *bobotel.InMemoryExporter) []string {
+	spans := exporter.GetRecordedSpans()
+	names := make([]string, 0, len(spans))
+
+	for _, span := range spans {
+		names = append(names, span.Name)
+	}
+
+	return names
+}
@@ -0,0 +1,54 @@
+package boboteltest
+
+import (
+	"context"
+	"encoding/binary"
+	"sync"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// SequentialIDGenerator generates sequential trace and span IDs starting from 1, which makes trace and span IDs
+// deterministic in tests (e.g. for golden-file span assertions). It is intended for use via the bobotel.Config
+// OtelIDGenerator field.
+type SequentialIDGenerator struct {
+	lock    sync.Mutex
+	traceID uint64
+	spanID  uint64
+}
+
+// NewSequentialIDGenerator creates a SequentialIDGenerator.
+func NewSequentialIDGenerator() *SequentialIDGenerator {
+	return &SequentialIDGenerator{}
+}
+
+// NewIDs returns the next trace ID and span ID.
+func (g *SequentialIDGenerator) NewIDs(context.Context) (trace.TraceID, trace.SpanID) {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	g.traceID++
+	g.spanID++
+
+	traceID := trace.TraceID{}
+	binary.BigEndian.PutUint64(traceID[8:], g.traceID)
+
+	return traceID, g.newSpanID()
+}
+
+// NewSpanID returns the next span ID.
+func (g *SequentialIDGenerator) NewSpanID(context.Context, trace.TraceID) trace.SpanID {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	g.spanID++
+
+	return g.newSpanID()
+}
+
+func (g *SequentialIDGenerator) newSpanID() trace.SpanID {
+	spanID := trace.SpanID{}
+	binary.BigEndian.PutUint64(spanID[:], g.spanID)
+
+	return spanID
+}
@@ -2,9 +2,16 @@ package bobotel
 
 import (
 	"fmt"
+	"io"
+	"net/url"
 	"slices"
+	"strings"
+	"time"
 
 	"github.com/xavi-group/bconf"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"google.golang.org/grpc"
 )
 
 const (
@@ -12,22 +19,137 @@ const (
 	OtelFieldSetKey = "otel"
 	// OtlpFieldSetKey defines the field-set key for open-telemetry protocol configuration fields.
 	OtlpFieldSetKey = "otlp"
+	// SpanLimitsFieldSetKey defines the field-set key for open-telemetry span limits configuration fields.
+	SpanLimitsFieldSetKey = "span_limits"
+	// ZipkinFieldSetKey defines the field-set key for zipkin exporter configuration fields.
+	ZipkinFieldSetKey = "zipkin"
 
 	// OtelExportersKey defines the field key for the open-telemetry exporters field.
 	OtelExportersKey = "exporters"
+	// OtelMetricExportersKey defines the field key for the open-telemetry metric_exporters field.
+	OtelMetricExportersKey = "metric_exporters"
+	// OtelLogExportersKey defines the field key for the open-telemetry log_exporters field.
+	OtelLogExportersKey = "log_exporters"
+	// OtelFallbackExporterKey defines the field key for the open-telemetry fallback_exporter field.
+	OtelFallbackExporterKey = "fallback_exporter"
 	// OtelConsoleFormatKey defines the field key for the open-telemetry console_format field.
 	OtelConsoleFormatKey = "console_format"
+	// OtelConsoleOutputKey defines the field key for the open-telemetry console_output field.
+	OtelConsoleOutputKey = "console_output"
+	// OtelConsoleMinDurationKey defines the field key for the open-telemetry console_min_duration field.
+	OtelConsoleMinDurationKey = "console_min_duration"
+	// OtelResourceDetectorsKey defines the field key for the open-telemetry resource_detectors field.
+	OtelResourceDetectorsKey = "resource_detectors"
+	// OtelMinimalResourceKey defines the field key for the open-telemetry minimal_resource field.
+	OtelMinimalResourceKey = "minimal_resource"
+	// OtelServiceNamespaceKey defines the field key for the open-telemetry service_namespace field.
+	OtelServiceNamespaceKey = "service_namespace"
+
+	// OtelFilePathKey defines the field key for the open-telemetry file_path field.
+	OtelFilePathKey = "file_path"
+	// OtelFileMaxSizeKey defines the field key for the open-telemetry file_max_size field.
+	OtelFileMaxSizeKey = "file_max_size"
+	// OtelSamplerKey defines the field key for the open-telemetry sampler field.
+	OtelSamplerKey = "sampler"
+	// OtelSamplerRatioKey defines the field key for the open-telemetry sampler_ratio field.
+	OtelSamplerRatioKey = "sampler_ratio"
+	// OtelSamplerRemoteParentSampledKey defines the field key for the open-telemetry sampler_remote_parent_sampled
+	// field.
+	OtelSamplerRemoteParentSampledKey = "sampler_remote_parent_sampled"
+	// OtelSamplerRemoteParentNotSampledKey defines the field key for the open-telemetry
+	// sampler_remote_parent_not_sampled field.
+	OtelSamplerRemoteParentNotSampledKey = "sampler_remote_parent_not_sampled"
+	// OtelSamplerLocalParentSampledKey defines the field key for the open-telemetry sampler_local_parent_sampled
+	// field.
+	OtelSamplerLocalParentSampledKey = "sampler_local_parent_sampled"
+	// OtelSamplerLocalParentNotSampledKey defines the field key for the open-telemetry
+	// sampler_local_parent_not_sampled field.
+	OtelSamplerLocalParentNotSampledKey = "sampler_local_parent_not_sampled"
+	// OtelSamplingHintAttributeKey defines the field key for the open-telemetry sampling_hint_attribute field.
+	OtelSamplingHintAttributeKey = "sampling_hint_attribute"
+	// OtelSetGlobalKey defines the field key for the open-telemetry set_global field.
+	OtelSetGlobalKey = "set_global"
+	// OtelFailOpenKey defines the field key for the open-telemetry fail_open field.
+	OtelFailOpenKey = "fail_open"
+	// OtelPropagatorsKey defines the field key for the open-telemetry propagators field.
+	OtelPropagatorsKey = "propagators"
+	// OtelMaxBaggageBytesKey defines the field key for the open-telemetry max_baggage_bytes field.
+	OtelMaxBaggageBytesKey = "max_baggage_bytes"
+	// OtelIDFormatKey defines the field key for the open-telemetry id_format field.
+	OtelIDFormatKey = "id_format"
+	// OtelSpanProcessorKey defines the field key for the open-telemetry span_processor field.
+	OtelSpanProcessorKey = "span_processor"
+	// OtelExporterSpanProcessorsKey defines the field key for the open-telemetry exporter_span_processors field.
+	OtelExporterSpanProcessorsKey = "exporter_span_processors"
+	// OtelBatchTimeoutKey defines the field key for the open-telemetry batch_timeout field.
+	OtelBatchTimeoutKey = "batch_timeout"
+	// OtelExportTimeoutKey defines the field key for the open-telemetry export_timeout field.
+	OtelExportTimeoutKey = "export_timeout"
+	// OtelMaxExportBatchSizeKey defines the field key for the open-telemetry max_export_batch_size field.
+	OtelMaxExportBatchSizeKey = "max_export_batch_size"
+	// OtelMaxQueueSizeKey defines the field key for the open-telemetry max_queue_size field.
+	OtelMaxQueueSizeKey = "max_queue_size"
+	// OtelSlowSpanThresholdKey defines the field key for the open-telemetry slow_span_threshold field.
+	OtelSlowSpanThresholdKey = "slow_span_threshold"
+	// OtelPeriodicFlushIntervalKey defines the field key for the open-telemetry periodic_flush_interval field.
+	OtelPeriodicFlushIntervalKey = "periodic_flush_interval"
 
 	// OtlpEndpointKindKey defines the field key for the open-telemetry protocol endpoint_kind field.
 	OtlpEndpointKindKey = "endpoint_kind"
+	// OtlpEndpointURLKey defines the field key for the open-telemetry protocol endpoint_url field.
+	OtlpEndpointURLKey = "endpoint_url"
 	// OtlpHostKey defines the field key for the open-telemetry protocol host field.
 	OtlpHostKey = "host"
 	// OtlpPortKey defines the field key for the open-telemetry protocol port field.
 	OtlpPortKey = "port"
+	// OtlpCompressionKey defines the field key for the open-telemetry protocol compression field.
+	OtlpCompressionKey = "compression"
+	// OtlpSampleRatioKey defines the field key for the open-telemetry protocol sample_ratio field.
+	OtlpSampleRatioKey = "sample_ratio"
+	// OtlpTimeoutKey defines the field key for the open-telemetry protocol timeout field.
+	OtlpTimeoutKey = "timeout"
+	// OtlpRetryDisabledKey defines the field key for the open-telemetry protocol retry_disabled field.
+	OtlpRetryDisabledKey = "retry_disabled"
+	// OtlpRetryInitialIntervalKey defines the field key for the open-telemetry protocol retry_initial_interval field.
+	OtlpRetryInitialIntervalKey = "retry_initial_interval"
+	// OtlpRetryMaxIntervalKey defines the field key for the open-telemetry protocol retry_max_interval field.
+	OtlpRetryMaxIntervalKey = "retry_max_interval"
+	// OtlpRetryMaxElapsedTimeKey defines the field key for the open-telemetry protocol retry_max_elapsed_time field.
+	OtlpRetryMaxElapsedTimeKey = "retry_max_elapsed_time"
+	// OtlpConnectBlockingKey defines the field key for the open-telemetry protocol connect_blocking field.
+	OtlpConnectBlockingKey = "connect_blocking"
+	// OtlpUserAgentKey defines the field key for the open-telemetry protocol user_agent field.
+	OtlpUserAgentKey = "user_agent"
+	// OtlpHTTPPathKey defines the field key for the open-telemetry protocol http_path field.
+	OtlpHTTPPathKey = "http_path"
+	// OtlpHTTPEncodingKey defines the field key for the open-telemetry protocol http_encoding field.
+	OtlpHTTPEncodingKey = "http_encoding"
+	// OtlpInsecureKey defines the field key for the open-telemetry protocol insecure field.
+	OtlpInsecureKey = "insecure"
+	// OtlpTLSEnabledKey defines the field key for the open-telemetry protocol tls_enabled field.
+	OtlpTLSEnabledKey = "tls_enabled"
+	// OtlpCACertPathKey defines the field key for the open-telemetry protocol ca_cert_path field.
+	OtlpCACertPathKey = "ca_cert_path"
+	// OtlpClientCertPathKey defines the field key for the open-telemetry protocol client_cert_path field.
+	OtlpClientCertPathKey = "client_cert_path"
+	// OtlpClientKeyPathKey defines the field key for the open-telemetry protocol client_key_path field.
+	OtlpClientKeyPathKey = "client_key_path"
+
+	// SpanLimitsMaxAttributesPerSpanKey defines the field key for the span limits max_attributes_per_span field.
+	SpanLimitsMaxAttributesPerSpanKey = "max_attributes_per_span"
+	// SpanLimitsMaxEventsPerSpanKey defines the field key for the span limits max_events_per_span field.
+	SpanLimitsMaxEventsPerSpanKey = "max_events_per_span"
+	// SpanLimitsMaxLinksPerSpanKey defines the field key for the span limits max_links_per_span field.
+	SpanLimitsMaxLinksPerSpanKey = "max_links_per_span"
+	// SpanLimitsMaxAttributeValueLengthKey defines the field key for the span limits max_attribute_value_length field.
+	SpanLimitsMaxAttributeValueLengthKey = "max_attribute_value_length"
+
+	// ZipkinCollectorURLKey defines the field key for the zipkin collector_url field.
+	ZipkinCollectorURLKey = "collector_url"
 )
 
 // NewConfig provides an initialized Config struct, and sets the returned config struct as the default config used when
-// calling InitializeTraceProvider(config ...*Config) with no args.
+// calling InitializeTraceProvider without a Config.
 func NewConfig() *Config {
 	configLock.Lock()
 	defer configLock.Unlock()
@@ -37,17 +159,252 @@ func NewConfig() *Config {
 	return defaultConfig
 }
 
+// DefaultConfig provides a Config with the same defaults as the bconf field-sets, exporting spans to the console in
+// the production format. The otlp fields are prefilled for a local http collector (localhost:4318, where the port is
+// left unset so that it follows the endpoint kind) over TLS, so exporting to a default collector listening in
+// plaintext requires adding the 'otlp' exporter and enabling OtlpInsecure. Unlike NewConfig, the returned config is not
+// set as the default config used by InitializeTraceProvider, and must be passed to it explicitly.
+func DefaultConfig(appName, appID string) *Config {
+	return &Config{
+		AppName:                   appName,
+		AppID:                     appID,
+		OtelExporters:             []string{"console"},
+		OtelConsoleFormat:         "production",
+		OtelConsoleOutput:         "stdout",
+		OtelSampler:               "parentbased_always_on",
+		OtelSamplerRatio:          1.0,
+		OtelSamplingHintAttribute: defaultSamplingHintAttribute,
+		OtelSetGlobal:             true,
+		OtelPropagators:           slices.Clone(defaultPropagators),
+		OtelIDFormat:              "random",
+		OtelSpanProcessor:         "batch",
+		OtlpEndpointKind:          "http",
+		OtlpHost:                  "localhost",
+		OtlpCompression:           "gzip",
+		OtlpSampleRatio:           "1.0",
+		OtlpRetryInitialInterval:  defaultOtlpRetryInitialInterval,
+		OtlpRetryMaxInterval:      defaultOtlpRetryMaxInterval,
+		OtlpRetryMaxElapsedTime:   defaultOtlpRetryMaxElapsedTime,
+		OtlpHTTPEncoding:          "protobuf",
+		ZipkinCollectorURL:        "http://localhost:9411/api/v2/spans",
+	}
+}
+
 // Config defines the expected values for configuring an open-telemetry tracer. It is recommended to initialize a
 // Config with bobotel.NewConfig(), which will set the default configuration struct for initializing a trace provider.
+// When a Config is constructed directly rather than loaded via bconf, field defaults are not applied (e.g.
+// OtelSetGlobal must be set to true in order to register the global trace provider), and the config is validated via
+// Config.Validate when creating a trace provider.
 type Config struct {
 	bconf.ConfigStruct
-	AppID             string   `bconf:"app.id"`
-	AppName           string   `bconf:"app.name"`
-	OtelExporters     []string `bconf:"otel.exporters"`
-	OtelConsoleFormat string   `bconf:"otel.console_format"`
-	OtlpEndpointKind  string   `bconf:"otlp.endpoint_kind"`
-	OtlpHost          string   `bconf:"otlp.host"`
-	OtlpPort          int      `bconf:"otlp.port"`
+	AppID                             string        `bconf:"app.id"`
+	AppName                           string        `bconf:"app.name"`
+	ServiceVersion                    string        `bconf:"app.version"`
+	OtelExporters                     []string      `bconf:"otel.exporters"`
+	OtelFallbackExporter              string        `bconf:"otel.fallback_exporter"`
+	OtelConsoleFormat                 string        `bconf:"otel.console_format"`
+	OtelConsoleOutput                 string        `bconf:"otel.console_output"`
+	OtelConsoleMinDuration            time.Duration `bconf:"otel.console_min_duration"`
+	OtelResourceDetectors             []string      `bconf:"otel.resource_detectors"`
+	OtelMinimalResource               bool          `bconf:"otel.minimal_resource"`
+	OtelServiceNamespace              string        `bconf:"otel.service_namespace"`
+	OtelFilePath                      string        `bconf:"otel.file_path"`
+	OtelFileMaxSize                   int           `bconf:"otel.file_max_size"`
+	OtelSampler                       string        `bconf:"otel.sampler"`
+	OtelSamplerRatio                  float64       `bconf:"otel.sampler_ratio"`
+	OtelSamplerRemoteParentSampled    string        `bconf:"otel.sampler_remote_parent_sampled"`
+	OtelSamplerRemoteParentNotSampled string        `bconf:"otel.sampler_remote_parent_not_sampled"`
+	OtelSamplerLocalParentSampled     string        `bconf:"otel.sampler_local_parent_sampled"`
+	OtelSamplerLocalParentNotSampled  string        `bconf:"otel.sampler_local_parent_not_sampled"`
+	OtelSamplingHintAttribute         string        `bconf:"otel.sampling_hint_attribute"`
+	OtelSetGlobal                     bool          `bconf:"otel.set_global"`
+	OtelFailOpen                      bool          `bconf:"otel.fail_open"`
+	OtelPropagators                   []string      `bconf:"otel.propagators"`
+	OtelMaxBaggageBytes               int           `bconf:"otel.max_baggage_bytes"`
+	OtelIDFormat                      string        `bconf:"otel.id_format"`
+	OtelSpanProcessor                 string        `bconf:"otel.span_processor"`
+	OtelExporterSpanProcessors        []string      `bconf:"otel.exporter_span_processors"`
+	OtelBatchTimeout                  time.Duration `bconf:"otel.batch_timeout"`
+	OtelExportTimeout                 time.Duration `bconf:"otel.export_timeout"`
+	OtelMaxExportBatchSize            int           `bconf:"otel.max_export_batch_size"`
+	OtelMaxQueueSize                  int           `bconf:"otel.max_queue_size"`
+	OtelSlowSpanThreshold             time.Duration `bconf:"otel.slow_span_threshold"`
+	OtelPeriodicFlushInterval         time.Duration `bconf:"otel.periodic_flush_interval"`
+	OtlpEndpointKind                  string        `bconf:"otlp.endpoint_kind"`
+	OtlpEndpointURL                   string        `bconf:"otlp.endpoint_url"`
+	OtlpHost                          string        `bconf:"otlp.host"`
+	OtlpPort                          int           `bconf:"otlp.port"`
+	OtlpCompression                   string        `bconf:"otlp.compression"`
+	OtlpSampleRatio                   string        `bconf:"otlp.sample_ratio"`
+	OtlpTimeout                       time.Duration `bconf:"otlp.timeout"`
+	OtlpRetryDisabled                 bool          `bconf:"otlp.retry_disabled"`
+	OtlpRetryInitialInterval          time.Duration `bconf:"otlp.retry_initial_interval"`
+	OtlpRetryMaxInterval              time.Duration `bconf:"otlp.retry_max_interval"`
+	OtlpRetryMaxElapsedTime           time.Duration `bconf:"otlp.retry_max_elapsed_time"`
+	OtlpConnectBlocking               bool          `bconf:"otlp.connect_blocking"`
+	OtlpHTTPPath                      string        `bconf:"otlp.http_path"`
+	OtlpHTTPEncoding                  string        `bconf:"otlp.http_encoding"`
+	OtlpUserAgent                     string        `bconf:"otlp.user_agent"`
+	OtlpInsecure                      bool          `bconf:"otlp.insecure"`
+	OtlpTLSEnabled                    bool          `bconf:"otlp.tls_enabled"`
+	OtlpCACertPath                    string        `bconf:"otlp.ca_cert_path"`
+	OtlpClientCertPath                string        `bconf:"otlp.client_cert_path"`
+	OtlpClientKeyPath                 string        `bconf:"otlp.client_key_path"`
+	SpanLimitsMaxAttributesPerSpan    int           `bconf:"span_limits.max_attributes_per_span"`
+	SpanLimitsMaxEventsPerSpan        int           `bconf:"span_limits.max_events_per_span"`
+	SpanLimitsMaxLinksPerSpan         int           `bconf:"span_limits.max_links_per_span"`
+	SpanLimitsMaxAttributeValueLength int           `bconf:"span_limits.max_attribute_value_length"`
+	ZipkinCollectorURL                string        `bconf:"zipkin.collector_url"`
+	// ResourceAttributes defines additional key/value pairs attached to the trace provider resource. The
+	// 'deployment.environment' key is mapped to the semantic convention deployment environment attribute.
+	ResourceAttributes map[string]string `bconf:"-"`
+	// ResourceDetectors defines additional resource detectors (e.g. for cloud or kubernetes metadata) merged into the
+	// trace provider resource after the detectors configured via OtelResourceDetectors.
+	ResourceDetectors []resource.Detector `bconf:"-"`
+	// OtlpHeaders defines additional headers sent with every otlp export request, e.g. collector authentication
+	// headers. Header values are treated as sensitive and are never logged.
+	OtlpHeaders map[string]string `bconf:"-"`
+	// OtlpGRPCDialOptions defines additional grpc dial options used by the otlp exporter for 'grpc' endpoints (e.g.
+	// keepalive parameters or interceptors). Transport credentials and compression are always set by bobotel.
+	OtlpGRPCDialOptions []grpc.DialOption `bconf:"-"`
+	// OtlpEndpoints defines additional otlp endpoints that traces are exported to alongside the primary otlp endpoint
+	// when the 'otlp' exporter is configured. Each endpoint is exported to via its own batch span processor.
+	OtlpEndpoints []OtlpEndpoint `bconf:"-"`
+	// OtelSamplerRules defines sampler rules evaluated in order before the configured sampler, where spans matching a
+	// rule are sampled with the ratio of the first matching rule (e.g. a ratio of 0 for health-check spans). Rules take
+	// precedence over parent based sampling, and spans matching no rule are sampled by the configured sampler.
+	OtelSamplerRules []SamplerRule `bconf:"-"`
+	// OtelIDGenerator defines an optional generator of trace and span IDs used instead of the ID generator configured
+	// via OtelIDFormat, e.g. boboteltest.NewSequentialIDGenerator() for deterministic IDs in tests.
+	OtelIDGenerator sdktrace.IDGenerator `bconf:"-"`
+	// SpanNameNormalizer defines an optional function applied to span names before they are exported, e.g. replacing
+	// ID path segments with a placeholder to limit span name cardinality. The normalizer is applied when spans are
+	// started and again when they are ended, so it must return normalized names unchanged.
+	SpanNameNormalizer func(name string) string `bconf:"-"`
+	// OtelTrackLeakedSpans defines whether spans started but not ended are tracked and reported via LeakedSpans, e.g.
+	// for detecting missing span.End() calls in tests. Tracking adds overhead to every span, and is intended for tests.
+	OtelTrackLeakedSpans bool `bconf:"-"`
+	// OtelConsoleWriter defines an optional writer that the 'console' exporter writes traces to instead of the
+	// configured console output, e.g. a buffer for capturing output in tests.
+	OtelConsoleWriter io.Writer `bconf:"-"`
+	// OtelErrorHandler defines an optional handler installed as the global open-telemetry error handler by
+	// InitializeTraceProvider, which receives errors such as failed exports (e.g. for rate-limiting or routing them to
+	// an application logger). A handler that ignores errors silences them entirely.
+	OtelErrorHandler func(err error) `bconf:"-"`
+	// OnInitialize defines an optional callback called with a summary of the configured trace provider after
+	// InitializeTraceProvider succeeds, e.g. for logging which exporters and endpoints are in use.
+	OnInitialize func(summary InitSummary) `bconf:"-"`
+}
+
+// OtlpEndpoint defines an additional otlp endpoint for the 'otlp' exporter. Unset kind and port values fall back to
+// the primary otlp endpoint configuration, while headers are never inherited from the primary endpoint. Other otlp
+// settings (e.g. TLS, compression, timeout, and retry) are shared with the primary endpoint.
+type OtlpEndpoint struct {
+	Kind    string
+	Host    string
+	Port    int
+	URL     string
+	Headers map[string]string
+}
+
+// SamplerRule defines a sampler rule, which matches spans by span name prefix and span start attributes. Attribute
+// values are compared with the string form of the attribute value, and a rule without a span name prefix or
+// attributes matches every span.
+type SamplerRule struct {
+	SpanNamePrefix string
+	Attributes     map[string]string
+	Ratio          float64
+}
+
+// NewMeterConfig provides an initialized MeterConfig struct, and sets the returned config struct as the default config
+// used when calling InitializeMeterProvider(config ...*MeterConfig) with no args.
+func NewMeterConfig() *MeterConfig {
+	meterConfigLock.Lock()
+	defer meterConfigLock.Unlock()
+
+	defaultMeterConfig = &MeterConfig{}
+
+	return defaultMeterConfig
+}
+
+// MeterConfig defines the expected values for configuring an open-telemetry meter. It is recommended to initialize a
+// MeterConfig with bobotel.NewMeterConfig(), which will set the default configuration struct for initializing a meter
+// provider.
+type MeterConfig struct {
+	bconf.ConfigStruct
+	AppID                    string        `bconf:"app.id"`
+	AppName                  string        `bconf:"app.name"`
+	OtelMetricExporters      []string      `bconf:"otel.metric_exporters"`
+	OtelConsoleFormat        string        `bconf:"otel.console_format"`
+	OtelConsoleOutput        string        `bconf:"otel.console_output"`
+	OtelMinimalResource      bool          `bconf:"otel.minimal_resource"`
+	OtlpEndpointKind         string        `bconf:"otlp.endpoint_kind"`
+	OtlpEndpointURL          string        `bconf:"otlp.endpoint_url"`
+	OtlpHost                 string        `bconf:"otlp.host"`
+	OtlpPort                 int           `bconf:"otlp.port"`
+	OtlpCompression          string        `bconf:"otlp.compression"`
+	OtlpTimeout              time.Duration `bconf:"otlp.timeout"`
+	OtlpRetryDisabled        bool          `bconf:"otlp.retry_disabled"`
+	OtlpRetryInitialInterval time.Duration `bconf:"otlp.retry_initial_interval"`
+	OtlpRetryMaxInterval     time.Duration `bconf:"otlp.retry_max_interval"`
+	OtlpRetryMaxElapsedTime  time.Duration `bconf:"otlp.retry_max_elapsed_time"`
+	OtlpUserAgent            string        `bconf:"otlp.user_agent"`
+	OtlpInsecure             bool          `bconf:"otlp.insecure"`
+	OtlpTLSEnabled           bool          `bconf:"otlp.tls_enabled"`
+	OtlpCACertPath           string        `bconf:"otlp.ca_cert_path"`
+	OtlpClientCertPath       string        `bconf:"otlp.client_cert_path"`
+	OtlpClientKeyPath        string        `bconf:"otlp.client_key_path"`
+	// OtlpHeaders defines additional headers sent with every otlp export request, e.g. collector authentication
+	// headers. Header values are treated as sensitive and are never logged.
+	OtlpHeaders map[string]string `bconf:"-"`
+}
+
+// NewLoggerConfig provides an initialized LoggerConfig struct, and sets the returned config struct as the default
+// config used when calling InitializeLoggerProvider(config ...*LoggerConfig) with no args.
+func NewLoggerConfig() *LoggerConfig {
+	loggerConfigLock.Lock()
+	defer loggerConfigLock.Unlock()
+
+	defaultLoggerConfig = &LoggerConfig{}
+
+	return defaultLoggerConfig
+}
+
+// LoggerConfig defines the expected values for configuring an open-telemetry logger. It is recommended to initialize a
+// LoggerConfig with bobotel.NewLoggerConfig(), which will set the default configuration struct for initializing a
+// logger provider.
+type LoggerConfig struct {
+	bconf.ConfigStruct
+	AppID                    string        `bconf:"app.id"`
+	AppName                  string        `bconf:"app.name"`
+	ServiceVersion           string        `bconf:"app.version"`
+	OtelLogExporters         []string      `bconf:"otel.log_exporters"`
+	OtelServiceNamespace     string        `bconf:"otel.service_namespace"`
+	OtelConsoleFormat        string        `bconf:"otel.console_format"`
+	OtelConsoleOutput        string        `bconf:"otel.console_output"`
+	OtelMinimalResource      bool          `bconf:"otel.minimal_resource"`
+	OtlpEndpointKind         string        `bconf:"otlp.endpoint_kind"`
+	OtlpEndpointURL          string        `bconf:"otlp.endpoint_url"`
+	OtlpHost                 string        `bconf:"otlp.host"`
+	OtlpPort                 int           `bconf:"otlp.port"`
+	OtlpCompression          string        `bconf:"otlp.compression"`
+	OtlpTimeout              time.Duration `bconf:"otlp.timeout"`
+	OtlpRetryDisabled        bool          `bconf:"otlp.retry_disabled"`
+	OtlpRetryInitialInterval time.Duration `bconf:"otlp.retry_initial_interval"`
+	OtlpRetryMaxInterval     time.Duration `bconf:"otlp.retry_max_interval"`
+	OtlpRetryMaxElapsedTime  time.Duration `bconf:"otlp.retry_max_elapsed_time"`
+	OtlpUserAgent            string        `bconf:"otlp.user_agent"`
+	OtlpInsecure             bool          `bconf:"otlp.insecure"`
+	OtlpTLSEnabled           bool          `bconf:"otlp.tls_enabled"`
+	OtlpCACertPath           string        `bconf:"otlp.ca_cert_path"`
+	OtlpClientCertPath       string        `bconf:"otlp.client_cert_path"`
+	OtlpClientKeyPath        string        `bconf:"otlp.client_key_path"`
+	// OtlpHeaders defines additional headers sent with every otlp export request, e.g. collector authentication
+	// headers. Header values are treated as sensitive and are never logged.
+	OtlpHeaders map[string]string `bconf:"-"`
+	// ResourceAttributes defines additional key/value pairs attached to the logger provider resource. The
+	// 'deployment.environment' key is mapped to the semantic convention deployment environment attribute.
+	ResourceAttributes map[string]string `bconf:"-"`
 }
 
 // FieldSets defines the field-sets for an open-telemetry tracer.
@@ -55,6 +412,8 @@ func FieldSets() bconf.FieldSets {
 	return bconf.FieldSets{
 		OtelFieldSet(),
 		OtlpFieldSet(),
+		SpanLimitsFieldSet(),
+		ZipkinFieldSet(),
 	}
 }
 
@@ -63,13 +422,202 @@ func OtelFieldSet() *bconf.FieldSet {
 	return bconf.FSB(OtelFieldSetKey).Fields(
 		bconf.FB(OtelExportersKey, bconf.Strings).Default([]string{"console"}).Validator(otelExportersValidator).
 			Description(
-				"Otel exporters defines where traces will be sent (accepted values are 'console' and 'otlp'). ",
+				"Otel exporters defines where traces will be sent (accepted values are 'console', 'otlp', 'file', ",
+				"'memory', and 'zipkin', or the name of an exporter registered via bobotel.RegisterExporter). ",
 				"Exporters accepts a list and can be configured to export traces to multiple destinations.",
 			).C(),
-		bconf.FB(OtelConsoleFormatKey, bconf.String).Default("production").Enumeration("production", "pretty").
+		bconf.FB(OtelMetricExportersKey, bconf.Strings).Default([]string{}).Validator(otelMetricExportersValidator).
+			Description(
+				"Otel metric exporters defines where metrics will be sent (accepted values are 'console' and ",
+				"'otlp'). Metric exporters accepts a list and can be configured to export metrics to multiple ",
+				"destinations.",
+			).C(),
+		bconf.FB(OtelLogExportersKey, bconf.Strings).Default([]string{}).Validator(otelLogExportersValidator).
+			Description(
+				"Otel log exporters defines where logs will be sent (accepted values are 'console' and 'otlp'). ",
+				"Log exporters accepts a list and can be configured to export logs to multiple destinations.",
+			).C(),
+		bconf.FB(OtelFallbackExporterKey, bconf.String).Enumeration("console").
+			Description(
+				"Otel fallback exporter defines the exporter that spans are routed to when the 'otlp' exporter ",
+				"fails to export them (accepted value is 'console'). After repeated consecutive failures, spans ",
+				"are routed directly to the fallback exporter, and the otlp endpoint is retried periodically. ",
+				"Fallback exporter is only used when the 'otlp' exporter is configured.",
+			).C(),
+		bconf.FB(OtelConsoleFormatKey, bconf.String).Default("production").
+			Enumeration("production", "json", "pretty", "logfmt").
+			Description(
+				"Otel console format defines the format of traces output to the console where 'production' and ",
+				"'json' output a single-line JSON object per span, 'pretty' is more human readable (adds ",
+				"whitespace), and 'logfmt' outputs a single key=value line per span (metrics and logs are output ",
+				"as single-line JSON objects). A console format other than the default without a 'console' ",
+				"trace exporter or fallback exporter is reported via the open-telemetry error handler.",
+			).C(),
+		bconf.FB(OtelConsoleOutputKey, bconf.String).Default("stdout").Enumeration("stdout", "stderr").
+			Description(
+				"Otel console output defines the output stream that 'console' exporters write traces, metrics, ",
+				"and logs to. A console output other than the default without a 'console' trace exporter or ",
+				"fallback exporter is reported via the open-telemetry error handler.",
+			).C(),
+		bconf.FB(OtelConsoleMinDurationKey, bconf.Duration).Validator(nonNegativeDurationValidator).
+			LoadConditions(
+				bconf.LCB(otelConsoleMinDurationLoadCondition).
+					AddFieldSetDependencies(OtelFieldSetKey, OtelExportersKey, OtelFallbackExporterKey).C(),
+			).
+			Description(
+				"Otel console min duration defines the minimum duration of spans output by the 'console' ",
+				"exporter, where shorter spans are dropped from console output only. Console min duration is ",
+				"only loaded when the 'console' exporter or fallback exporter is configured.",
+			).C(),
+		bconf.FB(OtelServiceNamespaceKey, bconf.String).
 			Description(
-				"Otel console format defines the format of traces output to the console where 'pretty' is more ",
-				"human readable (adds whitespace).",
+				"Otel service namespace defines the namespace of the service (e.g. a cluster or team name), which ",
+				"is added to the resource attributes when set.",
+			).C(),
+		bconf.FB(OtelResourceDetectorsKey, bconf.Strings).Default([]string{}).
+			Validator(otelResourceDetectorsValidator).
+			Description(
+				"Otel resource detectors defines additional resource attribute detectors (accepted values are ",
+				"'host', 'process', 'os', 'container', and 'build'). The 'build' detector adds the vcs revision, ",
+				"time, and modified state of the binary from its build info. Attributes from the ",
+				"OTEL_RESOURCE_ATTRIBUTES environment variable are always included.",
+			).C(),
+		bconf.FB(OtelMinimalResourceKey, bconf.Bool).Default(false).
+			Description(
+				"Otel minimal resource defines whether the resource is built without the open-telemetry SDK default ",
+				"resource (e.g. the telemetry.sdk attributes), so that the resource only contains the service ",
+				"attributes, explicitly configured resource attributes and detectors, and attributes from the ",
+				"OTEL_RESOURCE_ATTRIBUTES and OTEL_SERVICE_NAME environment variables.",
+			).C(),
+		bconf.FB(OtelFilePathKey, bconf.String).Required().
+			LoadConditions(
+				bconf.LCB(otelFileLoadCondition).AddFieldSetDependencies(OtelFieldSetKey, OtelExportersKey).C(),
+			).
+			Description("Otel file path defines the file that the 'file' exporter writes traces to as JSON lines.").C(),
+		bconf.FB(OtelFileMaxSizeKey, bconf.Int).Default(0).Validator(nonNegativeIntValidator).
+			LoadConditions(
+				bconf.LCB(otelFileLoadCondition).AddFieldSetDependencies(OtelFieldSetKey, OtelExportersKey).C(),
+			).
+			Description(
+				"Otel file max size defines the size in megabytes after which the trace file is rotated to ",
+				"'<file_path>.1'. A value of 0 disables rotation.",
+			).C(),
+		bconf.FB(OtelSamplerKey, bconf.String).Default("parentbased_always_on").
+			Enumeration("always_on", "always_off", "traceidratio", "parentbased_always_on", "parentbased_traceidratio").
+			Description(
+				"Otel sampler defines the head-based sampling strategy used when starting new spans. The ratio based ",
+				"samplers use the value of sampler_ratio.",
+			).C(),
+		bconf.FB(OtelSamplerRatioKey, bconf.Float).Default(1.0).Validator(otelSamplerRatioValidator).
+			Description(
+				"Otel sampler ratio defines the fraction of traces sampled by the 'traceidratio' and ",
+				"'parentbased_traceidratio' samplers (accepted values are between 0.0 and 1.0). The configured ",
+				"value is the initial ratio, which can be changed at runtime via bobotel.SetSamplingRatio.",
+			).C(),
+		bconf.FB(OtelSamplerRemoteParentSampledKey, bconf.String).
+			Enumeration("always_on", "always_off", "traceidratio").
+			Description(
+				"Otel sampler remote parent sampled defines the sampler used by the parent based samplers for spans ",
+				"with a sampled remote parent. When unset, spans are always sampled.",
+			).C(),
+		bconf.FB(OtelSamplerRemoteParentNotSampledKey, bconf.String).
+			Enumeration("always_on", "always_off", "traceidratio").
+			Description(
+				"Otel sampler remote parent not sampled defines the sampler used by the parent based samplers for ",
+				"spans with a remote parent that is not sampled. When unset, spans are never sampled.",
+			).C(),
+		bconf.FB(OtelSamplerLocalParentSampledKey, bconf.String).
+			Enumeration("always_on", "always_off", "traceidratio").
+			Description(
+				"Otel sampler local parent sampled defines the sampler used by the parent based samplers for spans ",
+				"with a sampled local parent. When unset, spans are always sampled.",
+			).C(),
+		bconf.FB(OtelSamplerLocalParentNotSampledKey, bconf.String).
+			Enumeration("always_on", "always_off", "traceidratio").
+			Description(
+				"Otel sampler local parent not sampled defines the sampler used by the parent based samplers for ",
+				"spans with a local parent that is not sampled. When unset, spans are never sampled.",
+			).C(),
+		bconf.FB(OtelSamplingHintAttributeKey, bconf.String).Default(defaultSamplingHintAttribute).
+			Description(
+				"Otel sampling hint attribute defines the attribute key set to 1 on spans marked via ",
+				"bobotel.MarkForSampling, which a collector tail sampler can use to retain the marked traces.",
+			).C(),
+		bconf.FB(OtelSetGlobalKey, bconf.Bool).Default(true).
+			Description(
+				"Otel set global defines whether the initialized trace provider is also registered as the global ",
+				"open-telemetry trace provider, which is used by third-party instrumentation libraries.",
+			).C(),
+		bconf.FB(OtelFailOpenKey, bconf.Bool).Default(false).
+			Description(
+				"Otel fail open defines whether InitializeTraceProvider falls back to a no-op trace provider when ",
+				"the configured trace provider cannot be created (e.g. an unreachable otlp endpoint), reporting ",
+				"the error via the open-telemetry error handler instead of returning it.",
+			).C(),
+		bconf.FB(OtelPropagatorsKey, bconf.Strings).Default([]string{"tracecontext", "baggage"}).
+			Validator(otelPropagatorsValidator).
+			Description(
+				"Otel propagators defines the context propagation formats registered globally (accepted values are ",
+				"'tracecontext', 'baggage', 'b3', 'b3-single', 'b3-multi', and 'xray'). The 'b3' and 'b3-multi' ",
+				"propagators inject multiple b3 headers, and 'b3-single' injects the single b3 header, where all b3 ",
+				"propagators extract both formats. The 'xray' propagator uses the aws x-ray trace header. ",
+				"Propagators are composed in the order provided.",
+			).C(),
+		bconf.FB(OtelMaxBaggageBytesKey, bconf.Int).Default(0).Validator(nonNegativeIntValidator).
+			Description(
+				"Otel max baggage bytes defines the maximum size of baggage extracted by the 'baggage' propagator, ",
+				"where incoming baggage exceeding the limit is truncated to the leading members that fit within ",
+				"the limit. A value of 0 disables the limit.",
+			).C(),
+		bconf.FB(OtelIDFormatKey, bconf.String).Default("random").Enumeration("random", "xray").
+			Description(
+				"Otel id format defines how trace and span IDs are generated, where 'random' uses the ",
+				"open-telemetry sdk's random ID generator, and 'xray' generates aws x-ray compatible trace IDs ",
+				"prefixed with the start time. The 'xray' format is recommended with the 'xray' propagator.",
+			).C(),
+		bconf.FB(OtelSpanProcessorKey, bconf.String).Default("batch").Enumeration("batch", "simple").
+			Description(
+				"Otel span processor defines how spans are handed to exporters, where 'simple' exports each span ",
+				"synchronously as it ends (intended for tests and low-volume services).",
+			).C(),
+		bconf.FB(OtelExporterSpanProcessorsKey, bconf.Strings).Default([]string{}).
+			Validator(otelExporterSpanProcessorsValidator).
+			Description(
+				"Otel exporter span processors defines span processors for individual exporters as ",
+				"'exporter=processor' values (e.g. 'console=simple'), which take precedence over span_processor. ",
+				"The 'memory' exporter always exports synchronously.",
+			).C(),
+		bconf.FB(OtelBatchTimeoutKey, bconf.Duration).Validator(nonNegativeDurationValidator).
+			Description(
+				"Otel batch timeout defines the maximum delay between exports of batched spans. When unset the ",
+				"open-telemetry SDK default (5s) is used.",
+			).C(),
+		bconf.FB(OtelExportTimeoutKey, bconf.Duration).Validator(nonNegativeDurationValidator).
+			Description(
+				"Otel export timeout defines how long a batch export may run before it is cancelled. When unset ",
+				"the open-telemetry SDK default (30s) is used.",
+			).C(),
+		bconf.FB(OtelMaxExportBatchSizeKey, bconf.Int).Validator(nonNegativeIntValidator).
+			Description(
+				"Otel max export batch size defines the maximum number of spans sent in a single export. When ",
+				"unset the open-telemetry SDK default (512) is used.",
+			).C(),
+		bconf.FB(OtelMaxQueueSizeKey, bconf.Int).Validator(nonNegativeIntValidator).
+			Description(
+				"Otel max queue size defines the maximum number of spans buffered before new spans are dropped. ",
+				"When unset the open-telemetry SDK default (2048) is used.",
+			).C(),
+		bconf.FB(OtelSlowSpanThresholdKey, bconf.Duration).Validator(nonNegativeDurationValidator).
+			Description(
+				"Otel slow span threshold defines the duration above which exported spans are flagged with the ",
+				"'slow=true' attribute. When unset spans are not flagged.",
+			).C(),
+		bconf.FB(OtelPeriodicFlushIntervalKey, bconf.Duration).Validator(nonNegativeDurationValidator).
+			Description(
+				"Otel periodic flush interval defines the interval at which pending spans are force flushed to ",
+				"exporters, which bounds export latency for low-traffic services regardless of the batch size. ",
+				"Periodic flushing stops when the trace provider is shut down. When unset spans are not flushed ",
+				"periodically.",
 			).C(),
 	).C()
 }
@@ -79,14 +627,155 @@ func OtlpFieldSet() *bconf.FieldSet {
 	return bconf.FSB(OtlpFieldSetKey).Fields(
 		bconf.FB(OtlpEndpointKindKey, bconf.String).Default("http").Enumeration("http", "grpc").
 			Description("Otlp endpoint kind defines the protocol used by the trace collector.").C(),
-		bconf.FB(OtlpHostKey, bconf.String).Required().
-			Description("Otlp host defines the host location of the trace collector.").C(),
-		bconf.FB(OtlpPortKey, bconf.Int).Default(4318).
+		bconf.FB(OtlpEndpointURLKey, bconf.String).Default("").Validator(otlpEndpointURLValidator).
+			Description(
+				"Otlp endpoint url defines the full url of the trace collector (e.g. 'https://collector:4318'). When ",
+				"set, the endpoint url takes precedence over host and port.",
+			).C(),
+		// NOTE: host is not marked as required, as the standard otlp endpoint environment variables are a fallback for
+		// an unset host, which is validated when the provider is created
+		bconf.FB(OtlpHostKey, bconf.String).
+			LoadConditions(
+				bconf.LCB(otlpHostLoadCondition).AddFieldSetDependencies(OtlpFieldSetKey, OtlpEndpointURLKey).C(),
+			).
+			Description(
+				"Otlp host defines the host location of the trace collector. Host is required unless endpoint_url ",
+				"or the OTEL_EXPORTER_OTLP_TRACES_ENDPOINT / OTEL_EXPORTER_OTLP_ENDPOINT environment variables ",
+				"are set, which are used for traces when neither endpoint_url nor host is set. A missing host is ",
+				"reported when the provider is created.",
+			).C(),
+		bconf.FB(OtlpPortKey, bconf.Int).Validator(otlpPortValidator).
+			Description(
+				"Otlp port defines the port of the trace collector process. When unset the default port of the ",
+				"endpoint kind is used (4318 for 'http' and 4317 for 'grpc').",
+			).C(),
+		bconf.FB(OtlpCompressionKey, bconf.String).Default("gzip").Enumeration("none", "gzip").
+			Description(
+				"Otlp compression defines the compression applied to export requests sent to the trace ",
+				"collector.",
+			).C(),
+		bconf.FB(OtlpSampleRatioKey, bconf.String).Default("1.0").Validator(otlpSampleRatioValidator).
+			Description(
+				"Otlp sample ratio defines the ratio (between 0.0 and 1.0) of sampled traces exported by the ",
+				"'otlp' exporter, applied independently of the sampler so that other exporters (e.g. 'console') ",
+				"receive every sampled span. Traces are kept or dropped as a whole, where 0 drops every otlp ",
+				"export. When unset (empty), every sampled span is exported.",
+			).C(),
+		bconf.FB(OtlpTimeoutKey, bconf.Duration).Validator(nonNegativeDurationValidator).
+			Description(
+				"Otlp timeout defines the maximum duration of a single export request. When unset the ",
+				"open-telemetry SDK default (10s) is used.",
+			).C(),
+		bconf.FB(OtlpRetryDisabledKey, bconf.Bool).Default(false).
+			Description(
+				"Otlp retry disabled defines whether retries of failed export requests are disabled. Failed ",
+				"export requests are retried by default.",
+			).C(),
+		bconf.FB(OtlpRetryInitialIntervalKey, bconf.Duration).Default(defaultOtlpRetryInitialInterval).
+			Validator(nonNegativeDurationValidator).
+			Description("Otlp retry initial interval defines the delay before the first retry of a failed export.").C(),
+		bconf.FB(OtlpRetryMaxIntervalKey, bconf.Duration).Default(defaultOtlpRetryMaxInterval).
+			Validator(nonNegativeDurationValidator).
+			Description("Otlp retry max interval defines the upper bound of the exponential backoff between retries.").
+			C(),
+		bconf.FB(OtlpRetryMaxElapsedTimeKey, bconf.Duration).Default(defaultOtlpRetryMaxElapsedTime).
+			Validator(nonNegativeDurationValidator).
+			Description(
+				"Otlp retry max elapsed time defines the total time spent retrying an export before it is ",
+				"dropped.",
+			).C(),
+		bconf.FB(OtlpConnectBlockingKey, bconf.Bool).Default(false).
+			Description(
+				"Otlp connect blocking defines whether initialization blocks until a 'grpc' endpoint is reachable ",
+				"(bounded by timeout), failing fast if the collector is unreachable. When disabled the exporter ",
+				"connects lazily. Connect blocking has no effect for 'http' endpoints.",
+			).C(),
+		bconf.FB(OtlpHTTPPathKey, bconf.String).Default("").Validator(otlpHTTPPathValidator).
+			Description(
+				"Otlp http path defines the url path of export requests sent to an 'http' endpoint (e.g. a proxy ",
+				"prefix such as '/otlp/v1/traces'). When set, the http path takes precedence over the path of ",
+				"endpoint_url. When unset the default path ('/v1/traces') is used.",
+			).C(),
+		bconf.FB(OtlpHTTPEncodingKey, bconf.String).Default("protobuf").Enumeration("protobuf", "json").
+			Description(
+				"Otlp http encoding defines the payload encoding of export requests sent to an 'http' endpoint. ",
+				"The 'json' encoding does not support retries, and is not supported by 'grpc' endpoints.",
+			).C(),
+		bconf.FB(OtlpUserAgentKey, bconf.String).Default("").
+			Description(
+				"Otlp user agent defines the user-agent sent with export requests, e.g. for collectors that route ",
+				"or filter by user-agent. When unset the open-telemetry exporter user-agent is used.",
+			).C(),
+		bconf.FB(OtlpInsecureKey, bconf.Bool).Default(false).
+			Description(
+				"Otlp insecure defines whether exports are sent without transport security (plain http or an ",
+				"insecure grpc connection). When an endpoint url is set, an 'http' or 'grpc' scheme also ",
+				"disables transport security.",
+			).C(),
+		bconf.FB(OtlpTLSEnabledKey, bconf.Bool).Default(false).
+			Description(
+				"Otlp tls enabled defines whether a TLS connection is used to communicate with the trace ",
+				"collector.",
+			).C(),
+		bconf.FB(OtlpCACertPathKey, bconf.String).
+			Description(
+				"Otlp ca cert path defines the path to a PEM encoded CA certificate used to verify the trace ",
+				"collector. When unset the system certificate pool is used.",
+			).C(),
+		bconf.FB(OtlpClientCertPathKey, bconf.String).
+			Description(
+				"Otlp client cert path defines the path to a PEM encoded client certificate used for mutual TLS ",
+				"with the trace collector.",
+			).C(),
+		bconf.FB(OtlpClientKeyPathKey, bconf.String).
+			Description(
+				"Otlp client key path defines the path to the PEM encoded private key of the client certificate.",
+			).C(),
+	).LoadConditions(
+		bconf.LCB(otlpLoadCondition).
+			AddFieldSetDependencies(
+				OtelFieldSetKey, OtelExportersKey, OtelMetricExportersKey, OtelLogExportersKey,
+			).C(),
+	).C()
+}
+
+// SpanLimitsFieldSet defines the fields for open-telemetry span limits configuration.
+func SpanLimitsFieldSet() *bconf.FieldSet {
+	return bconf.FSB(SpanLimitsFieldSetKey).Fields(
+		bconf.FB(SpanLimitsMaxAttributesPerSpanKey, bconf.Int).Validator(nonNegativeIntValidator).
 			Description(
-				"Otlp port defines the port of the trace collector process. For a GRPC endpoint the default is 4317.",
+				"Span limits max attributes per span defines the maximum number of attributes recorded on a ",
+				"span. When unset, the open-telemetry sdk default is used.",
+			).C(),
+		bconf.FB(SpanLimitsMaxEventsPerSpanKey, bconf.Int).Validator(nonNegativeIntValidator).
+			Description(
+				"Span limits max events per span defines the maximum number of events recorded on a span. ",
+				"When unset, the open-telemetry sdk default is used.",
+			).C(),
+		bconf.FB(SpanLimitsMaxLinksPerSpanKey, bconf.Int).Validator(nonNegativeIntValidator).
+			Description(
+				"Span limits max links per span defines the maximum number of links recorded on a span. When ",
+				"unset, the open-telemetry sdk default is used.",
+			).C(),
+		bconf.FB(SpanLimitsMaxAttributeValueLengthKey, bconf.Int).Validator(nonNegativeIntValidator).
+			Description(
+				"Span limits max attribute value length defines the maximum length of string attribute values, ",
+				"where longer values are truncated. When unset, attribute values are not truncated.",
+			).C(),
+	).C()
+}
+
+// ZipkinFieldSet defines the fields for zipkin exporter configuration.
+func ZipkinFieldSet() *bconf.FieldSet {
+	return bconf.FSB(ZipkinFieldSetKey).Fields(
+		bconf.FB(ZipkinCollectorURLKey, bconf.String).Default("http://localhost:9411/api/v2/spans").
+			Validator(zipkinCollectorURLValidator).
+			Description(
+				"Zipkin collector url defines the url of the zipkin collector spans are sent to by the 'zipkin' ",
+				"exporter, e.g. 'http://localhost:9411/api/v2/spans'.",
 			).C(),
 	).LoadConditions(
-		bconf.LCB(otlpLoadCondition).AddFieldSetDependencies(OtelFieldSetKey, OtelExportersKey).C(),
+		bconf.LCB(zipkinLoadCondition).AddFieldSetDependencies(OtelFieldSetKey, OtelExportersKey).C(),
 	).C()
 }
 
@@ -96,8 +785,18 @@ func otlpLoadCondition(f bconf.FieldValueFinder) (bool, error) {
 		return false, fmt.Errorf("problem getting exporters field value")
 	}
 
+	metricExporters, found, err := f.GetStrings(OtelFieldSetKey, OtelMetricExportersKey)
+	if !found || err != nil {
+		return false, fmt.Errorf("problem getting metric exporters field value")
+	}
+
+	logExporters, found, err := f.GetStrings(OtelFieldSetKey, OtelLogExportersKey)
+	if !found || err != nil {
+		return false, fmt.Errorf("problem getting log exporters field value")
+	}
+
 	otlpExporterFound := false
-	for _, exporter := range exporters {
+	for _, exporter := range slices.Concat(exporters, metricExporters, logExporters) {
 		if exporter == "otlp" {
 			otlpExporterFound = true
 
@@ -108,7 +807,78 @@ func otlpLoadCondition(f bconf.FieldValueFinder) (bool, error) {
 	return otlpExporterFound, nil
 }
 
+func otelFileLoadCondition(f bconf.FieldValueFinder) (bool, error) {
+	exporters, found, err := f.GetStrings(OtelFieldSetKey, OtelExportersKey)
+	if !found || err != nil {
+		return false, fmt.Errorf("problem getting exporters field value")
+	}
+
+	return slices.Contains(exporters, "file"), nil
+}
+
+func otelConsoleMinDurationLoadCondition(f bconf.FieldValueFinder) (bool, error) {
+	exporters, found, err := f.GetStrings(OtelFieldSetKey, OtelExportersKey)
+	if !found || err != nil {
+		return false, fmt.Errorf("problem getting exporters field value")
+	}
+
+	fallbackExporter, err := otelFallbackExporterFieldValue(f)
+	if err != nil {
+		return false, err
+	}
+
+	return slices.Contains(exporters, "console") || fallbackExporter == "console", nil
+}
+
+// otelFallbackExporterFieldValue returns the fallback exporter field value, which is empty when no fallback exporter
+// is set.
+func otelFallbackExporterFieldValue(f bconf.FieldValueFinder) (string, error) {
+	fallbackExporter, _, err := f.GetString(OtelFieldSetKey, OtelFallbackExporterKey)
+	if err != nil {
+		return "", fmt.Errorf("problem getting fallback exporter field value")
+	}
+
+	return fallbackExporter, nil
+}
+
+func zipkinLoadCondition(f bconf.FieldValueFinder) (bool, error) {
+	exporters, found, err := f.GetStrings(OtelFieldSetKey, OtelExportersKey)
+	if !found || err != nil {
+		return false, fmt.Errorf("problem getting exporters field value")
+	}
+
+	return slices.Contains(exporters, "zipkin"), nil
+}
+
+func otlpHostLoadCondition(f bconf.FieldValueFinder) (bool, error) {
+	endpointURL, found, err := f.GetString(OtlpFieldSetKey, OtlpEndpointURLKey)
+	if !found || err != nil {
+		return false, fmt.Errorf("problem getting endpoint url field value")
+	}
+
+	return endpointURL == "", nil
+}
+
 func otelExportersValidator(v any) error {
+	fieldValues, ok := v.([]string)
+	if !ok {
+		return fmt.Errorf("unexpected field-value type provided to validator")
+	}
+
+	for _, value := range fieldValues {
+		if _, registered := registeredExporterFactory(value); registered {
+			continue
+		}
+
+		if found := slices.Contains(builtInExporters, value); !found {
+			return fmt.Errorf("invalid exporter value: '%s'", value)
+		}
+	}
+
+	return nil
+}
+
+func otelMetricExportersValidator(v any) error {
 	acceptedValues := []string{"console", "otlp"}
 
 	fieldValues, ok := v.([]string)
@@ -118,8 +888,198 @@ func otelExportersValidator(v any) error {
 
 	for _, value := range fieldValues {
 		if found := slices.Contains(acceptedValues, value); !found {
-			return fmt.Errorf("invalid exporter value: '%s'", value)
+			return fmt.Errorf("invalid metric exporter value: '%s'", value)
+		}
+	}
+
+	return nil
+}
+
+func otelLogExportersValidator(v any) error {
+	acceptedValues := []string{"console", "otlp"}
+
+	fieldValues, ok := v.([]string)
+	if !ok {
+		return fmt.Errorf("unexpected field-value type provided to validator")
+	}
+
+	for _, value := range fieldValues {
+		if found := slices.Contains(acceptedValues, value); !found {
+			return fmt.Errorf("invalid log exporter value: '%s'", value)
+		}
+	}
+
+	return nil
+}
+
+func otelResourceDetectorsValidator(v any) error {
+	acceptedValues := []string{"host", "process", "os", "container", "build"}
+
+	fieldValues, ok := v.([]string)
+	if !ok {
+		return fmt.Errorf("unexpected field-value type provided to validator")
+	}
+
+	for _, value := range fieldValues {
+		if found := slices.Contains(acceptedValues, value); !found {
+			return fmt.Errorf("invalid resource detector value: '%s'", value)
+		}
+	}
+
+	return nil
+}
+
+func otelPropagatorsValidator(v any) error {
+	acceptedValues := []string{"tracecontext", "baggage", "b3", "b3-single", "b3-multi", "xray"}
+
+	fieldValues, ok := v.([]string)
+	if !ok {
+		return fmt.Errorf("unexpected field-value type provided to validator")
+	}
+
+	for _, value := range fieldValues {
+		if found := slices.Contains(acceptedValues, value); !found {
+			return fmt.Errorf("invalid propagator value: '%s'", value)
+		}
+	}
+
+	return nil
+}
+
+func otelExporterSpanProcessorsValidator(v any) error {
+	fieldValues, ok := v.([]string)
+	if !ok {
+		return fmt.Errorf("unexpected field-value type provided to validator")
+	}
+
+	exporterNames := make([]string, 0, len(fieldValues))
+
+	for _, value := range fieldValues {
+		exporterName, spanProcessor, found := strings.Cut(value, "=")
+		if !found || exporterName == "" {
+			return fmt.Errorf("invalid exporter span processor value: '%s', expected 'exporter=processor'", value)
+		}
+
+		if spanProcessor != "batch" && spanProcessor != "simple" {
+			return fmt.Errorf("invalid exporter span processor value: '%s', expected 'batch' or 'simple'", value)
+		}
+
+		if slices.Contains(exporterNames, exporterName) {
+			return fmt.Errorf("duplicate exporter span processor found for exporter: '%s'", exporterName)
 		}
+
+		exporterNames = append(exporterNames, exporterName)
+	}
+
+	return nil
+}
+
+func otlpSampleRatioValidator(v any) error {
+	fieldValue, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("unexpected field-value type provided to validator")
+	}
+
+	_, err := parseOtlpSampleRatio(fieldValue)
+
+	return err
+}
+
+func otelSamplerRatioValidator(v any) error {
+	fieldValue, ok := v.(float64)
+	if !ok {
+		return fmt.Errorf("unexpected field-value type provided to validator")
+	}
+
+	if fieldValue < 0 || fieldValue > 1 {
+		return fmt.Errorf("invalid sampler ratio value: '%v', expected a value between 0.0 and 1.0", fieldValue)
+	}
+
+	return nil
+}
+
+func nonNegativeDurationValidator(v any) error {
+	fieldValue, ok := v.(time.Duration)
+	if !ok {
+		return fmt.Errorf("unexpected field-value type provided to validator")
+	}
+
+	if fieldValue < 0 {
+		return fmt.Errorf("invalid duration value: '%s', expected a non-negative duration", fieldValue)
+	}
+
+	return nil
+}
+
+func nonNegativeIntValidator(v any) error {
+	fieldValue, ok := v.(int)
+	if !ok {
+		return fmt.Errorf("unexpected field-value type provided to validator")
+	}
+
+	if fieldValue < 0 {
+		return fmt.Errorf("invalid value: '%d', expected a non-negative integer", fieldValue)
+	}
+
+	return nil
+}
+
+func otlpPortValidator(v any) error {
+	fieldValue, ok := v.(int)
+	if !ok {
+		return fmt.Errorf("unexpected field-value type provided to validator")
+	}
+
+	if fieldValue < 1 || fieldValue > 65535 {
+		return fmt.Errorf("invalid port value: '%d', expected a value between 1 and 65535", fieldValue)
+	}
+
+	return nil
+}
+
+func otlpEndpointURLValidator(v any) error {
+	fieldValue, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("unexpected field-value type provided to validator")
+	}
+
+	if fieldValue == "" {
+		return nil
+	}
+
+	if _, err := parseOtlpEndpointURL(fieldValue); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func otlpHTTPPathValidator(v any) error {
+	fieldValue, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("unexpected field-value type provided to validator")
+	}
+
+	if fieldValue != "" && !strings.HasPrefix(fieldValue, "/") {
+		return fmt.Errorf("invalid otlp http path: '%s', expected a path starting with '/'", fieldValue)
+	}
+
+	return nil
+}
+
+func zipkinCollectorURLValidator(v any) error {
+	fieldValue, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("unexpected field-value type provided to validator")
+	}
+
+	collectorURL, err := url.Parse(fieldValue)
+	if err != nil {
+		return fmt.Errorf("invalid zipkin collector url: %w", err)
+	}
+
+	if (collectorURL.Scheme != "http" && collectorURL.Scheme != "https") || collectorURL.Host == "" {
+		return fmt.Errorf("invalid zipkin collector url: '%s', expected an http(s) url", fieldValue)
 	}
 
 	return nil
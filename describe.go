@@ -0,0 +1,167 @@
+package bobotel
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/xavi-group/bconf"
+)
+
+// FieldSetDescription describes a field-set defined by FieldSets.
+type FieldSetDescription struct {
+	// Key defines the field-set key (e.g. 'otel').
+	Key string `json:"key"`
+	// Conditional is true when the field-set is only loaded when its load conditions are met.
+	Conditional bool `json:"conditional"`
+	// Fields describes the fields of the field-set.
+	Fields []FieldDescription `json:"fields"`
+}
+
+// FieldDescription describes a field of a field-set defined by FieldSets.
+type FieldDescription struct {
+	// Key defines the full field key, prefixed by the field-set key (e.g. 'otel.exporters').
+	Key string `json:"key"`
+	// Type defines the field-value type.
+	Type string `json:"type"`
+	// Default defines the default field-value, which is omitted for sensitive fields.
+	Default any `json:"default,omitempty"`
+	// Description defines the field description.
+	Description string `json:"description"`
+	// Enumeration defines the accepted field-values, and is empty when any valid field-value is accepted.
+	Enumeration []any `json:"enumeration,omitempty"`
+	// Required is true when the field must be set whenever it is loaded.
+	Required bool `json:"required"`
+	// Conditional is true when the field is only loaded when its load conditions are met.
+	Conditional bool `json:"conditional"`
+	// Sensitive is true when the field-value is sensitive.
+	Sensitive bool `json:"sensitive"`
+}
+
+// DescribeFieldSets returns a description of the fields defined by FieldSets.
+func DescribeFieldSets() []FieldSetDescription {
+	fieldSets := FieldSets()
+	descriptions := make([]FieldSetDescription, 0, len(fieldSets))
+
+	for _, fieldSet := range fieldSets {
+		descriptions = append(descriptions, newFieldSetDescription(fieldSet))
+	}
+
+	return descriptions
+}
+
+// DescribeConfig returns the documentation of the fields defined by FieldSets (keys, types, defaults, descriptions, and
+// accepted values) in the given format, where accepted formats are 'json' and 'markdown'.
+func DescribeConfig(format string) (string, error) {
+	descriptions := DescribeFieldSets()
+
+	switch format {
+	case "json":
+		encoded, err := json.MarshalIndent(descriptions, "", "  ")
+		if err != nil {
+			return "", fmt.Errorf("problem encoding config description: %w", err)
+		}
+
+		return string(encoded), nil
+	case "markdown":
+		return describeConfigMarkdown(descriptions), nil
+	default:
+		return "", fmt.Errorf("unsupported config description format: %s", format)
+	}
+}
+
+func newFieldSetDescription(fieldSet *bconf.FieldSet) FieldSetDescription {
+	description := FieldSetDescription{
+		Key:         fieldSet.Key,
+		Conditional: len(fieldSet.LoadConditions) > 0,
+		Fields:      make([]FieldDescription, 0, len(fieldSet.Fields)),
+	}
+
+	for _, field := range fieldSet.Fields {
+		fieldDescription := FieldDescription{
+			Key:         fmt.Sprintf("%s.%s", fieldSet.Key, field.Key),
+			Type:        field.Type,
+			Description: strings.TrimSpace(field.Description),
+			Enumeration: field.Enumeration,
+			Required:    field.Required,
+			Conditional: len(field.LoadConditions) > 0,
+			Sensitive:   field.Sensitive,
+		}
+
+		if !field.Sensitive {
+			fieldDescription.Default = describeDefault(field.Default)
+		}
+
+		description.Fields = append(description.Fields, fieldDescription)
+	}
+
+	return description
+}
+
+// describeDefault formats duration defaults as strings (e.g. '5s'), which are otherwise encoded as nanoseconds.
+func describeDefault(value any) any {
+	switch v := value.(type) {
+	case time.Duration:
+		return v.String()
+	case []time.Duration:
+		durations := make([]string, 0, len(v))
+
+		for _, duration := range v {
+			durations = append(durations, duration.String())
+		}
+
+		return durations
+	default:
+		return value
+	}
+}
+
+func describeConfigMarkdown(descriptions []FieldSetDescription) string {
+	builder := strings.Builder{}
+
+	for idx, fieldSet := range descriptions {
+		if idx > 0 {
+			builder.WriteString("\n")
+		}
+
+		fmt.Fprintf(&builder, "## %s\n\n", fieldSet.Key)
+
+		if fieldSet.Conditional {
+			builder.WriteString("Fields are only loaded when the field-set load conditions are met.\n\n")
+		}
+
+		builder.WriteString("| Key | Type | Default | Required | Accepted Values | Description |\n")
+		builder.WriteString("| --- | --- | --- | --- | --- | --- |\n")
+
+		for _, field := range fieldSet.Fields {
+			required := "no"
+
+			switch {
+			case field.Required && field.Conditional:
+				required = "conditionally"
+			case field.Required:
+				required = "yes"
+			}
+
+			defaultValue := ""
+			if field.Default != nil {
+				defaultValue = fmt.Sprintf("`%v`", field.Default)
+			}
+
+			enumeration := make([]string, 0, len(field.Enumeration))
+
+			for _, value := range field.Enumeration {
+				enumeration = append(enumeration, fmt.Sprintf("`%v`", value))
+			}
+
+			fmt.Fprintf(
+				&builder, "| `%s` | %s | %s | %s | %s | %s |\n",
+				field.Key, field.Type, defaultValue, required, strings.Join(enumeration, ", "),
+				strings.ReplaceAll(field.Description, "|", "\\|"),
+			)
+		}
+	}
+
+	return builder.String()
+}
@@ -0,0 +1,58 @@
+package bobotel
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// ExporterFactory creates a span exporter configured via the given Config.
+type ExporterFactory func(c *Config) (sdktrace.SpanExporter, error)
+
+var builtInExporters = []string{"console", "otlp", "file", "memory", "zipkin"}
+
+var (
+	exporterFactoriesLock sync.RWMutex
+	exporterFactories     = map[string]ExporterFactory{}
+)
+
+// RegisterExporter registers a span exporter factory under the given name, which can then be used as an exporter
+// value (e.g. 'otel.exporters=console,myexporter'). Exporters created by a registered factory use the configured
+// span processor. RegisterExporter must be called before the configuration is loaded and the trace provider is
+// initialized, and returns an error if the name is empty, is a built-in exporter name, or is already registered.
+func RegisterExporter(name string, factory ExporterFactory) error {
+	if name == "" {
+		return errors.New("no exporter name provided")
+	}
+
+	if factory == nil {
+		return fmt.Errorf("no exporter factory provided for exporter: %s", name)
+	}
+
+	if slices.Contains(builtInExporters, name) {
+		return fmt.Errorf("exporter name is reserved for a built-in exporter: %s", name)
+	}
+
+	exporterFactoriesLock.Lock()
+	defer exporterFactoriesLock.Unlock()
+
+	if _, found := exporterFactories[name]; found {
+		return fmt.Errorf("exporter already registered: %s", name)
+	}
+
+	exporterFactories[name] = factory
+
+	return nil
+}
+
+func registeredExporterFactory(name string) (ExporterFactory, bool) {
+	exporterFactoriesLock.RLock()
+	defer exporterFactoriesLock.RUnlock()
+
+	factory, found := exporterFactories[name]
+
+	return factory, found
+}
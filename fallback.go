@@ -0,0 +1,106 @@
+package bobotel
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+const (
+	// fallbackExporterFailureThreshold defines the number of consecutive primary exporter failures after which spans
+	// are routed directly to the fallback exporter.
+	fallbackExporterFailureThreshold = 3
+	// fallbackExporterRetryInterval defines the interval at which the primary exporter is retried once spans are
+	// routed directly to the fallback exporter.
+	fallbackExporterRetryInterval = 30 * time.Second
+)
+
+// newFallbackExporter wraps the given otlp span exporter with the configured fallback exporter, and returns the given
+// span exporter when no fallback exporter is configured.
+func newFallbackExporter(c *Config, exporter sdktrace.SpanExporter) (sdktrace.SpanExporter, error) {
+	switch c.OtelFallbackExporter {
+	case "":
+		return exporter, nil
+	case "console":
+		consoleExporter, err := newConsoleExporter(c)
+		if err != nil {
+			return nil, fmt.Errorf("problem creating fallback console exporter: %w", err)
+		}
+
+		return &fallbackExporter{
+			primary:  exporter,
+			fallback: newMinDurationExporter(consoleExporter, c.OtelConsoleMinDuration),
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported fallback exporter found: %s", c.OtelFallbackExporter)
+	}
+}
+
+// fallbackExporter wraps a primary span exporter, and exports spans that the primary exporter fails to export to the
+// fallback exporter. Once the primary exporter has failed repeatedly, spans are exported directly to the fallback
+// exporter, and the primary exporter is retried at the retry interval until an export succeeds.
+type fallbackExporter struct {
+	primary  sdktrace.SpanExporter
+	fallback sdktrace.SpanExporter
+
+	lock     sync.Mutex
+	failures int
+	retryAt  time.Time
+}
+
+func (e *fallbackExporter) ExportSpans(ctx context.Context, spans []sdktrace.ReadOnlySpan) error {
+	if e.usePrimary() {
+		err := e.primary.ExportSpans(ctx, spans)
+		e.recordResult(err)
+
+		if err == nil {
+			return nil
+		}
+
+		otel.Handle(fmt.Errorf("bobotel primary exporter failed, exporting spans to fallback exporter: %w", err))
+	}
+
+	if err := e.fallback.ExportSpans(ctx, spans); err != nil {
+		return fmt.Errorf("problem exporting spans to fallback exporter: %w", err)
+	}
+
+	return nil
+}
+
+func (e *fallbackExporter) Shutdown(ctx context.Context) error {
+	primaryErr := e.primary.Shutdown(ctx)
+
+	if err := e.fallback.Shutdown(ctx); err != nil {
+		return fmt.Errorf("problem shutting down fallback exporter: %w", err)
+	}
+
+	return primaryErr
+}
+
+func (e *fallbackExporter) usePrimary() bool {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	return e.failures < fallbackExporterFailureThreshold || !time.Now().Before(e.retryAt)
+}
+
+func (e *fallbackExporter) recordResult(err error) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	if err == nil {
+		e.failures = 0
+
+		return
+	}
+
+	e.failures++
+
+	if e.failures >= fallbackExporterFailureThreshold {
+		e.retryAt = time.Now().Add(fallbackExporterRetryInterval)
+	}
+}
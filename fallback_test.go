@@ -0,0 +1,139 @@
+package bobotel
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// failingExporter is a span exporter that fails while err is set, and counts export attempts.
+type failingExporter struct {
+	err     error
+	exports int
+}
+
+func (e *failingExporter) ExportSpans(context.Context, []sdktrace.ReadOnlySpan) error {
+	e.exports++
+
+	return e.err
+}
+
+func (e *failingExporter) Shutdown(context.Context) error {
+	return nil
+}
+
+func TestNewFallbackExporter(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   *Config
+		fallback bool
+		wantErr  bool
+	}{
+		{name: "no fallback exporter", config: &Config{}},
+		{name: "console fallback exporter", config: &Config{OtelFallbackExporter: "console"}, fallback: true},
+		{name: "unsupported fallback exporter", config: &Config{OtelFallbackExporter: "file"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exporter, err := newFallbackExporter(tt.config, &failingExporter{})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("newFallbackExporter() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if _, ok := exporter.(*fallbackExporter); ok != tt.fallback {
+				t.Errorf("newFallbackExporter() = %T, expected fallback exporter %v", exporter, tt.fallback)
+			}
+		})
+	}
+}
+
+func TestFallbackExporter(t *testing.T) {
+	spans := []sdktrace.ReadOnlySpan{newTestSpan(0x01, time.Second)}
+	exportErr := errors.New("export failed")
+
+	tests := []struct {
+		name             string
+		primaryErr       error
+		attempts         int
+		primaryExports   int
+		fallbackExported int
+	}{
+		{
+			name:             "primary exporter succeeds",
+			attempts:         fallbackExporterFailureThreshold + 1,
+			primaryExports:   fallbackExporterFailureThreshold + 1,
+			fallbackExported: 0,
+		},
+		{
+			name:             "failed exports routed to fallback exporter",
+			primaryErr:       exportErr,
+			attempts:         fallbackExporterFailureThreshold,
+			primaryExports:   fallbackExporterFailureThreshold,
+			fallbackExported: fallbackExporterFailureThreshold,
+		},
+		{
+			name:             "primary exporter skipped after failure threshold",
+			primaryErr:       exportErr,
+			attempts:         fallbackExporterFailureThreshold + 2,
+			primaryExports:   fallbackExporterFailureThreshold,
+			fallbackExported: fallbackExporterFailureThreshold + 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			primary := &failingExporter{err: tt.primaryErr}
+			fallback := NewInMemoryExporter()
+			exporter := &fallbackExporter{primary: primary, fallback: fallback}
+
+			for range tt.attempts {
+				if err := exporter.ExportSpans(context.Background(), spans); err != nil {
+					t.Fatalf("ExportSpans() error = %v", err)
+				}
+			}
+
+			if primary.exports != tt.primaryExports {
+				t.Errorf("primary exports = %d, expected %d", primary.exports, tt.primaryExports)
+			}
+
+			if exported := len(fallback.GetRecordedSpans()); exported != tt.fallbackExported {
+				t.Errorf("fallback exported %d spans, expected %d", exported, tt.fallbackExported)
+			}
+		})
+	}
+}
+
+func TestFallbackExporterRetriesPrimary(t *testing.T) {
+	spans := []sdktrace.ReadOnlySpan{newTestSpan(0x01, time.Second)}
+	primary := &failingExporter{err: errors.New("export failed")}
+	fallback := NewInMemoryExporter()
+	exporter := &fallbackExporter{primary: primary, fallback: fallback}
+
+	for range fallbackExporterFailureThreshold {
+		_ = exporter.ExportSpans(context.Background(), spans)
+	}
+
+	// NOTE: the retry interval is elapsed, and the recovered primary exporter resets the failure count
+	exporter.retryAt = time.Now()
+	primary.err = nil
+
+	if err := exporter.ExportSpans(context.Background(), spans); err != nil {
+		t.Fatalf("ExportSpans() error = %v", err)
+	}
+
+	if primary.exports != fallbackExporterFailureThreshold+1 {
+		t.Errorf("primary exports = %d, expected %d", primary.exports, fallbackExporterFailureThreshold+1)
+	}
+
+	if exporter.failures != 0 {
+		t.Errorf("failures = %d, expected 0 after a successful export", exporter.failures)
+	}
+
+	if exported := len(fallback.GetRecordedSpans()); exported != fallbackExporterFailureThreshold {
+		t.Errorf("fallback exported %d spans, expected %d", exported, fallbackExporterFailureThreshold)
+	}
+}
@@ -0,0 +1,143 @@
+package bobotel
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+const bytesPerMegabyte = 1024 * 1024
+
+func newFileExporter(c *Config) (sdktrace.SpanExporter, *rotatingFileWriter, error) {
+	if c.OtelFilePath == "" {
+		return nil, nil, fmt.Errorf("no file path provided for file exporter")
+	}
+
+	writer, err := newRotatingFileWriter(c.OtelFilePath, int64(c.OtelFileMaxSize)*bytesPerMegabyte)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	exporter, err := stdouttrace.New(stdouttrace.WithWriter(writer))
+	if err != nil {
+		_ = writer.Close()
+
+		return nil, nil, err
+	}
+
+	return exporter, writer, nil
+}
+
+// rotatingFileWriter is an io.WriteCloser that appends to a file, and rotates the file to '<path>.1' once the file
+// size exceeds the max size. A max size of 0 disables rotation.
+type rotatingFileWriter struct {
+	lock    sync.Mutex
+	path    string
+	maxSize int64
+	size    int64
+	file    *os.File
+}
+
+func newRotatingFileWriter(path string, maxSize int64) (*rotatingFileWriter, error) {
+	w := &rotatingFileWriter{path: path, maxSize: maxSize}
+
+	if err := w.open(); err != nil {
+		return nil, err
+	}
+
+	return w, nil
+}
+
+func (w *rotatingFileWriter) Write(p []byte) (int, error) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	if w.file == nil {
+		return 0, fmt.Errorf("file exporter writer for '%s' is closed", w.path)
+	}
+
+	if w.maxSize > 0 && w.size > 0 && w.size+int64(len(p)) > w.maxSize {
+		if err := w.rotate(); err != nil {
+			return 0, err
+		}
+	}
+
+	n, err := w.file.Write(p)
+	w.size += int64(n)
+
+	return n, err
+}
+
+func (w *rotatingFileWriter) Close() error {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	if w.file == nil {
+		return nil
+	}
+
+	err := w.file.Close()
+	w.file = nil
+
+	if err != nil {
+		return fmt.Errorf("problem closing file exporter file: %w", err)
+	}
+
+	return nil
+}
+
+func (w *rotatingFileWriter) open() error {
+	file, size, err := openFileExporterFile(w.path)
+	if err != nil {
+		return err
+	}
+
+	w.file = file
+	w.size = size
+
+	return nil
+}
+
+// rotate renames the current file to '<path>.1', and swaps to a newly opened file at the path. The current file is
+// kept open until the new file is opened, so that the writer keeps writing to the current file if rotation fails.
+func (w *rotatingFileWriter) rotate() error {
+	if err := os.Rename(w.path, w.path+".1"); err != nil {
+		return fmt.Errorf("problem rotating file exporter file: %w", err)
+	}
+
+	file, size, err := openFileExporterFile(w.path)
+	if err != nil {
+		// NOTE: the current file is restored to the path, so that writes continue to the path until rotation succeeds
+		return errors.Join(err, os.Rename(w.path+".1", w.path))
+	}
+
+	if err = w.file.Close(); err != nil {
+		otel.Handle(fmt.Errorf("problem closing rotated file exporter file: %w", err))
+	}
+
+	w.file = file
+	w.size = size
+
+	return nil
+}
+
+func openFileExporterFile(path string) (*os.File, int64, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, 0, fmt.Errorf("problem opening file exporter file: %w", err)
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+
+		return nil, 0, fmt.Errorf("problem reading file exporter file info: %w", err)
+	}
+
+	return file, info.Size(), nil
+}
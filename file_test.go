@@ -0,0 +1,140 @@
+package bobotel
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestRotatingFileWriter(t *testing.T) {
+	tests := []struct {
+		name            string
+		maxSize         int64
+		writes          []string
+		expected        string
+		expectedRotated string
+	}{
+		{
+			name:     "rotation disabled",
+			maxSize:  0,
+			writes:   []string{"first\n", "second\n", "third\n"},
+			expected: "first\nsecond\nthird\n",
+		},
+		{
+			name:     "writes within max size",
+			maxSize:  64,
+			writes:   []string{"first\n", "second\n"},
+			expected: "first\nsecond\n",
+		},
+		{
+			name:            "rotates once max size is exceeded",
+			maxSize:         13,
+			writes:          []string{"first\n", "second\n", "third\n"},
+			expected:        "third\n",
+			expectedRotated: "first\nsecond\n",
+		},
+		{
+			name:     "write larger than max size to an empty file",
+			maxSize:  4,
+			writes:   []string{"larger than max size\n"},
+			expected: "larger than max size\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "traces.jsonl")
+
+			writer, err := newRotatingFileWriter(path, tt.maxSize)
+			if err != nil {
+				t.Fatalf("newRotatingFileWriter() error = %v", err)
+			}
+
+			for _, write := range tt.writes {
+				if _, err = writer.Write([]byte(write)); err != nil {
+					t.Fatalf("Write() error = %v", err)
+				}
+			}
+
+			if err = writer.Close(); err != nil {
+				t.Fatalf("Close() error = %v", err)
+			}
+
+			if contents := readTestFile(t, path); contents != tt.expected {
+				t.Errorf("file contents = %q, expected %q", contents, tt.expected)
+			}
+
+			if rotated := readTestFile(t, path+".1"); rotated != tt.expectedRotated {
+				t.Errorf("rotated file contents = %q, expected %q", rotated, tt.expectedRotated)
+			}
+		})
+	}
+}
+
+func TestRotatingFileWriterAppendsToExistingFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "traces.jsonl")
+
+	if err := os.WriteFile(path, []byte("existing\n"), 0o644); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+
+	writer, err := newRotatingFileWriter(path, 12)
+	if err != nil {
+		t.Fatalf("newRotatingFileWriter() error = %v", err)
+	}
+
+	if writer.size != int64(len("existing\n")) {
+		t.Errorf("size = %d, expected the existing file size %d", writer.size, len("existing\n"))
+	}
+
+	if _, err = writer.Write([]byte("new\n")); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+
+	if err = writer.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	if rotated := readTestFile(t, path+".1"); rotated != "existing\n" {
+		t.Errorf("rotated file contents = %q, expected %q", rotated, "existing\n")
+	}
+
+	if contents := readTestFile(t, path); contents != "new\n" {
+		t.Errorf("file contents = %q, expected %q", contents, "new\n")
+	}
+}
+
+func TestRotatingFileWriterClose(t *testing.T) {
+	writer, err := newRotatingFileWriter(filepath.Join(t.TempDir(), "traces.jsonl"), 0)
+	if err != nil {
+		t.Fatalf("newRotatingFileWriter() error = %v", err)
+	}
+
+	if err = writer.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	if err = writer.Close(); err != nil {
+		t.Errorf("Close() of a closed writer error = %v, expected nil", err)
+	}
+
+	if _, err = writer.Write([]byte("closed\n")); err == nil {
+		t.Error("Write() to a closed writer error = nil, expected an error")
+	}
+}
+
+// readTestFile returns the contents of the file at the given path, or an empty string when the file does not exist.
+func readTestFile(t *testing.T, path string) string {
+	t.Helper()
+
+	contents, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return ""
+	}
+
+	if err != nil {
+		t.Fatalf("ReadFile() error = %v", err)
+	}
+
+	return string(contents)
+}
@@ -0,0 +1,69 @@
+package bobotel
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// periodicFlusher force flushes a trace provider at a fixed interval until stopped, where flush errors are reported
+// via the open-telemetry error handler.
+type periodicFlusher struct {
+	stopCh   chan struct{}
+	doneCh   chan struct{}
+	stopOnce sync.Once
+}
+
+// newPeriodicFlusher starts force flushing the given trace provider at the configured periodic flush interval, and
+// returns nil when no interval is configured.
+func newPeriodicFlusher(c *Config, provider *sdktrace.TracerProvider) *periodicFlusher {
+	if c.OtelPeriodicFlushInterval <= 0 {
+		return nil
+	}
+
+	flusher := &periodicFlusher{stopCh: make(chan struct{}), doneCh: make(chan struct{})}
+
+	go flusher.run(provider, c.OtelPeriodicFlushInterval)
+
+	return flusher
+}
+
+func (f *periodicFlusher) run(provider *sdktrace.TracerProvider, interval time.Duration) {
+	defer close(f.doneCh)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-f.stopCh:
+			return
+		case <-ticker.C:
+			// NOTE: each flush is bounded by the interval, so that a blocked export cannot delay later flushes
+			ctx, cancel := context.WithTimeout(context.Background(), interval)
+
+			if err := provider.ForceFlush(ctx); err != nil {
+				otel.Handle(fmt.Errorf("bobotel periodic flush failed: %w", err))
+			}
+
+			cancel()
+		}
+	}
+}
+
+// stop stops periodic flushing, and waits for an in-progress flush to complete. Stop is a no-op for a nil flusher.
+func (f *periodicFlusher) stop() {
+	if f == nil {
+		return
+	}
+
+	f.stopOnce.Do(func() {
+		close(f.stopCh)
+	})
+
+	<-f.doneCh
+}
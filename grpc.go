@@ -0,0 +1,68 @@
+package bobotel
+
+import (
+	"context"
+
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/stats"
+)
+
+// GRPCTracerName defines the tracer name used by the gRPC stats handlers.
+const GRPCTracerName = "github.com/xavi-group/bobotel/grpc"
+
+// GRPCServerHandler creates an otelgrpc server stats handler that starts a server span for each call, recording the rpc
+// semantic convention attributes and message events. Spans are started via the trace provider initialized via
+// InitializeTraceProvider, and context is extracted from incoming metadata via the configured propagators. The given
+// otelgrpc options are applied after the bobotel options, e.g. otelgrpc.WithMessageEvents or otelgrpc.WithFilter.
+func GRPCServerHandler(opts ...otelgrpc.Option) stats.Handler {
+	return otelgrpc.NewServerHandler(grpcHandlerOptions(opts)...)
+}
+
+// GRPCClientHandler creates an otelgrpc client stats handler that starts a client span for each call, recording the rpc
+// semantic convention attributes and message events. Spans are started via the trace provider initialized via
+// InitializeTraceProvider, and context is injected into outgoing metadata via the configured propagators. The given
+// otelgrpc options are applied after the bobotel options.
+func GRPCClientHandler(opts ...otelgrpc.Option) stats.Handler {
+	return otelgrpc.NewClientHandler(grpcHandlerOptions(opts)...)
+}
+
+// GRPCServerOption returns a grpc.ServerOption that instruments a gRPC server with GRPCServerHandler, e.g.
+// grpc.NewServer(bobotel.GRPCServerOption()).
+func GRPCServerOption(opts ...otelgrpc.Option) grpc.ServerOption {
+	return grpc.StatsHandler(GRPCServerHandler(opts...))
+}
+
+// GRPCDialOption returns a grpc.DialOption that instruments a gRPC client connection with GRPCClientHandler, e.g.
+// grpc.NewClient(target, bobotel.GRPCDialOption()).
+func GRPCDialOption(opts ...otelgrpc.Option) grpc.DialOption {
+	return grpc.WithStatsHandler(GRPCClientHandler(opts...))
+}
+
+// grpcHandlerOptions returns the otelgrpc options for the bobotel trace provider and propagators, followed by the
+// given options. The tracer provider and propagator are resolved per call, so that stats handlers created before
+// InitializeTraceProvider use the trace provider once it is initialized.
+func grpcHandlerOptions(opts []otelgrpc.Option) []otelgrpc.Option {
+	return append([]otelgrpc.Option{
+		otelgrpc.WithTracerProvider(lazyTracerProvider{tracerName: GRPCTracerName}),
+		otelgrpc.WithPropagators(globalPropagator{}),
+	}, opts...)
+}
+
+// globalPropagator delegates to the global propagator at the time of each call, which is replaced by
+// InitializeTraceProvider.
+type globalPropagator struct{}
+
+func (globalPropagator) Inject(ctx context.Context, carrier propagation.TextMapCarrier) {
+	otel.GetTextMapPropagator().Inject(ctx, carrier)
+}
+
+func (globalPropagator) Extract(ctx context.Context, carrier propagation.TextMapCarrier) context.Context {
+	return otel.GetTextMapPropagator().Extract(ctx, carrier)
+}
+
+func (globalPropagator) Fields() []string {
+	return otel.GetTextMapPropagator().Fields()
+}
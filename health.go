@@ -0,0 +1,95 @@
+package bobotel
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// CheckExporterHealth verifies that the otlp endpoints of the trace provider initialized via InitializeTraceProvider
+// are reachable, e.g. for use by a readiness check. An error is returned if the trace provider is not initialized.
+// See Provider.CheckExporterHealth for details.
+func CheckExporterHealth(ctx context.Context) error {
+	traceProviderLock.RLock()
+
+	if singletonProvider == nil {
+		traceProviderLock.RUnlock()
+
+		return errors.New("trace provider not initialized")
+	}
+
+	// NOTE: the endpoint configs are copied so that the lock is not held while the endpoints are reached
+	endpointConfigs := copyOtlpEndpointConfigs(singletonProvider.otlpEndpoints)
+
+	traceProviderLock.RUnlock()
+
+	return checkOtlpEndpointsHealth(ctx, endpointConfigs)
+}
+
+// CheckExporterHealth verifies that the provider's otlp endpoints (including additional endpoints) are reachable by
+// uploading an empty export request to each endpoint, bounded by the given context and the otlp timeout. Health checks
+// are not retried, and are independent of the provider's exporters. Nil is returned when the 'otlp' exporter is not
+// configured.
+func (p *Provider) CheckExporterHealth(ctx context.Context) error {
+	return checkOtlpEndpointsHealth(ctx, p.otlpEndpoints)
+}
+
+func checkOtlpEndpointsHealth(ctx context.Context, endpointConfigs []*Config) error {
+	errs := []error{}
+
+	for _, endpointConfig := range endpointConfigs {
+		if err := checkOtlpEndpointHealth(ctx, endpointConfig); err != nil {
+			endpoint := newOtlpEndpointSummary(endpointConfig).Endpoint
+			errs = append(errs, fmt.Errorf("problem reaching otlp endpoint '%s': %w", endpoint, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func copyOtlpEndpointConfigs(endpointConfigs []*Config) []*Config {
+	configs := make([]*Config, 0, len(endpointConfigs))
+
+	for _, endpointConfig := range endpointConfigs {
+		config := *endpointConfig
+		configs = append(configs, &config)
+	}
+
+	return configs
+}
+
+func checkOtlpEndpointHealth(ctx context.Context, c *Config) error {
+	healthConfig := *c
+	healthConfig.OtlpConnectBlocking = false
+	healthConfig.OtlpRetryDisabled = true
+
+	client, err := newOtlpClient(ctx, &healthConfig)
+	if err != nil {
+		return err
+	}
+
+	if err = client.Start(ctx); err != nil {
+		return err
+	}
+
+	err = client.UploadTraces(ctx, nil)
+
+	return errors.Join(err, client.Stop(context.WithoutCancel(ctx)))
+}
+
+// newOtlpEndpointConfigs returns the configs of the primary otlp endpoint and additional otlp endpoints when the
+// 'otlp' exporter is configured.
+func newOtlpEndpointConfigs(c *Config) []*Config {
+	if !slices.Contains(c.OtelExporters, "otlp") {
+		return nil
+	}
+
+	endpointConfigs := []*Config{c}
+
+	for _, endpoint := range c.OtlpEndpoints {
+		endpointConfigs = append(endpointConfigs, otlpEndpointConfig(c, endpoint))
+	}
+
+	return endpointConfigs
+}
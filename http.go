@@ -0,0 +1,175 @@
+package bobotel
+
+import (
+	"net/http"
+	"strings"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.39.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// redactedQueryValue defines the value that query parameter values are replaced with by WithRedactedURLQuery.
+const redactedQueryValue = "REDACTED"
+
+// HTTPMiddlewareOption defines an option for HTTPMiddleware.
+type HTTPMiddlewareOption func(*httpMiddlewareOptions)
+
+type httpMiddlewareOptions struct {
+	requestHeaders  []string
+	responseHeaders []string
+	captureQuery    bool
+	redactQuery     bool
+}
+
+// WithHTTPRequestHeaders records the values of the given request headers on server spans as
+// 'http.request.header.<name>' attributes.
+func WithHTTPRequestHeaders(names ...string) HTTPMiddlewareOption {
+	return func(o *httpMiddlewareOptions) {
+		o.requestHeaders = append(o.requestHeaders, names...)
+	}
+}
+
+// WithHTTPResponseHeaders records the values of the given response headers on server spans as
+// 'http.response.header.<name>' attributes.
+func WithHTTPResponseHeaders(names ...string) HTTPMiddlewareOption {
+	return func(o *httpMiddlewareOptions) {
+		o.responseHeaders = append(o.responseHeaders, names...)
+	}
+}
+
+// WithURLQuery records the request query string on server spans as the 'url.query' attribute. Query strings may
+// contain sensitive values, in which case WithRedactedURLQuery should be used instead.
+func WithURLQuery() HTTPMiddlewareOption {
+	return func(o *httpMiddlewareOptions) {
+		o.captureQuery = true
+		o.redactQuery = false
+	}
+}
+
+// WithRedactedURLQuery records the request query string on server spans as the 'url.query' attribute, where each
+// query parameter value is replaced with 'REDACTED', e.g. 'user=REDACTED&page=REDACTED'.
+func WithRedactedURLQuery() HTTPMiddlewareOption {
+	return func(o *httpMiddlewareOptions) {
+		o.captureQuery = true
+		o.redactQuery = true
+	}
+}
+
+// HTTPMiddleware creates net/http middleware that starts a server span for each request using the tracer with the
+// given tracer name. Server spans record the http semantic convention attributes, including the request method,
+// matched route, response status code, and request and response body sizes, and the given options capture additional
+// request attributes. Context is extracted from incoming requests via the propagators configured by
+// InitializeTraceProvider. Requests are passed through without instrumentation while IsInitialized returns false.
+func HTTPMiddleware(tracerName string, opts ...HTTPMiddlewareOption) func(http.Handler) http.Handler {
+	options := httpMiddlewareOptions{}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	return func(next http.Handler) http.Handler {
+		instrumented := otelhttp.NewHandler(
+			newHTTPAttributesHandler(next, options),
+			tracerName,
+			otelhttp.WithTracerProvider(lazyTracerProvider{tracerName: tracerName}),
+			otelhttp.WithSpanNameFormatter(httpSpanName),
+		)
+
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !IsInitialized() {
+				next.ServeHTTP(w, r)
+
+				return
+			}
+
+			instrumented.ServeHTTP(w, r)
+		})
+	}
+}
+
+// newHTTPAttributesHandler wraps the given handler with a handler that records the matched route and the optional
+// request and response attributes on the server span.
+func newHTTPAttributesHandler(next http.Handler, options httpMiddlewareOptions) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		span := trace.SpanFromContext(r.Context())
+
+		if span.IsRecording() {
+			span.SetAttributes(httpRequestAttributes(r, options)...)
+		}
+
+		next.ServeHTTP(w, r)
+
+		// NOTE: the route pattern is set by the router while serving, and response headers are read after the handler
+		// returns, so headers set after writing are not recorded
+		if span.IsRecording() {
+			if route := httpRoute(r.Pattern); route != "" {
+				span.SetAttributes(semconv.HTTPRoute(route))
+			}
+
+			for _, name := range options.responseHeaders {
+				if values := w.Header().Values(name); len(values) > 0 {
+					span.SetAttributes(semconv.HTTPResponseHeader(strings.ToLower(name), values...))
+				}
+			}
+		}
+	})
+}
+
+func httpRequestAttributes(r *http.Request, options httpMiddlewareOptions) []attribute.KeyValue {
+	attributes := []attribute.KeyValue{}
+
+	for _, name := range options.requestHeaders {
+		if values := r.Header.Values(name); len(values) > 0 {
+			attributes = append(attributes, semconv.HTTPRequestHeader(strings.ToLower(name), values...))
+		}
+	}
+
+	if options.captureQuery && r.URL != nil && r.URL.RawQuery != "" {
+		query := r.URL.RawQuery
+
+		if options.redactQuery {
+			query = redactQuery(query)
+		}
+
+		attributes = append(attributes, semconv.URLQuery(query))
+	}
+
+	return attributes
+}
+
+// redactQuery replaces each parameter value of the given raw query string, preserving the parameter order.
+func redactQuery(rawQuery string) string {
+	parameters := strings.Split(rawQuery, "&")
+
+	for idx, parameter := range parameters {
+		if key, _, found := strings.Cut(parameter, "="); found {
+			parameters[idx] = key + "=" + redactedQueryValue
+		}
+	}
+
+	return strings.Join(parameters, "&")
+}
+
+// httpRoute returns the route of the given route pattern without the request method, e.g. '/users/{id}'.
+func httpRoute(pattern string) string {
+	if _, route, found := strings.Cut(pattern, " "); found {
+		return route
+	}
+
+	return pattern
+}
+
+// httpSpanName names server spans by request method and matched route pattern, which avoids high-cardinality span
+// names from raw request paths.
+func httpSpanName(_ string, r *http.Request) string {
+	switch {
+	case r.Pattern == "":
+		return r.Method
+	case strings.Contains(r.Pattern, " "):
+		// NOTE: the pattern already contains the request method, e.g. 'GET /users/{id}'
+		return r.Pattern
+	default:
+		return r.Method + " " + r.Pattern
+	}
+}
@@ -0,0 +1,90 @@
+package bobotel
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// lastLeakedSpans defines the leaked span tracker of the last trace provider initialized via InitializeTraceProvider,
+// which is kept after the trace provider is shut down so that leaked spans can be reported at shutdown.
+var lastLeakedSpans *leakedSpanProcessor
+
+// LeakedSpans returns the names of the spans started but not ended on the last trace provider initialized via
+// InitializeTraceProvider, e.g. for detecting missing span.End() calls in tests. Leaked spans remain available after
+// the trace provider is shut down via ShutdownTraceProvider or Reset, until a trace provider is initialized again. Nil
+// is returned if the trace provider was not configured with OtelTrackLeakedSpans.
+func LeakedSpans() []string {
+	traceProviderLock.RLock()
+	defer traceProviderLock.RUnlock()
+
+	if lastLeakedSpans == nil {
+		return nil
+	}
+
+	return lastLeakedSpans.leakedSpans()
+}
+
+// leakedSpanKey identifies a span tracked by the leaked span processor, as the spans passed to OnStart and OnEnd are
+// not the same values.
+type leakedSpanKey struct {
+	traceID trace.TraceID
+	spanID  trace.SpanID
+}
+
+// leakedSpanProcessor tracks the spans that have been started but not ended. Only recording spans are passed to span
+// processors, so spans that are not sampled are not tracked.
+type leakedSpanProcessor struct {
+	lock  sync.Mutex
+	spans map[leakedSpanKey]sdktrace.ReadWriteSpan
+}
+
+func newLeakedSpanProcessor() *leakedSpanProcessor {
+	return &leakedSpanProcessor{spans: map[leakedSpanKey]sdktrace.ReadWriteSpan{}}
+}
+
+func (p *leakedSpanProcessor) OnStart(_ context.Context, s sdktrace.ReadWriteSpan) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	p.spans[newLeakedSpanKey(s)] = s
+}
+
+func (p *leakedSpanProcessor) OnEnd(s sdktrace.ReadOnlySpan) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	delete(p.spans, newLeakedSpanKey(s))
+}
+
+func (p *leakedSpanProcessor) Shutdown(context.Context) error {
+	return nil
+}
+
+func (p *leakedSpanProcessor) ForceFlush(context.Context) error {
+	return nil
+}
+
+// leakedSpans returns the sorted names of the tracked spans, where span names are read when called as they may be
+// updated after a span is started.
+func (p *leakedSpanProcessor) leakedSpans() []string {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	names := make([]string, 0, len(p.spans))
+
+	for _, span := range p.spans {
+		names = append(names, span.Name())
+	}
+
+	slices.Sort(names)
+
+	return names
+}
+
+func newLeakedSpanKey(s sdktrace.ReadOnlySpan) leakedSpanKey {
+	return leakedSpanKey{traceID: s.SpanContext().TraceID(), spanID: s.SpanContext().SpanID()}
+}
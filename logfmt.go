@@ -0,0 +1,121 @@
+package bobotel
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// logfmtExporter writes each span as a single logfmt line (e.g. 'time=... span=handleRequest trace_id=...'), followed
+// by the span attributes. Values containing whitespace, quotes, or '=' are quoted, and slice attribute values are
+// formatted as JSON arrays.
+type logfmtExporter struct {
+	lock    sync.Mutex
+	writer  io.Writer
+	stopped bool
+}
+
+func newLogfmtExporter(writer io.Writer) *logfmtExporter {
+	return &logfmtExporter{writer: writer}
+}
+
+func (e *logfmtExporter) ExportSpans(ctx context.Context, spans []sdktrace.ReadOnlySpan) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	if e.stopped {
+		return nil
+	}
+
+	for _, span := range spans {
+		if _, err := e.writer.Write(formatLogfmtSpan(span)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (e *logfmtExporter) Shutdown(ctx context.Context) error {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	e.stopped = true
+
+	return ctx.Err()
+}
+
+func formatLogfmtSpan(span sdktrace.ReadOnlySpan) []byte {
+	line := &bytes.Buffer{}
+
+	writeLogfmtPair(line, "time", span.EndTime().Format(time.RFC3339Nano))
+	writeLogfmtPair(line, "span", span.Name())
+	writeLogfmtPair(line, "trace_id", span.SpanContext().TraceID().String())
+	writeLogfmtPair(line, "span_id", span.SpanContext().SpanID().String())
+
+	if span.Parent().IsValid() {
+		writeLogfmtPair(line, "parent_span_id", span.Parent().SpanID().String())
+	}
+
+	writeLogfmtPair(line, "kind", span.SpanKind().String())
+	writeLogfmtPair(line, "duration", span.EndTime().Sub(span.StartTime()).String())
+
+	if span.Status().Code != codes.Unset {
+		writeLogfmtPair(line, "status", span.Status().Code.String())
+	}
+
+	if span.Status().Description != "" {
+		writeLogfmtPair(line, "status_message", span.Status().Description)
+	}
+
+	for _, attr := range span.Attributes() {
+		writeLogfmtPair(line, string(attr.Key), attr.Value.Emit())
+	}
+
+	line.WriteString("\n")
+
+	return line.Bytes()
+}
+
+func writeLogfmtPair(line *bytes.Buffer, key, value string) {
+	if line.Len() > 0 {
+		line.WriteString(" ")
+	}
+
+	line.WriteString(logfmtKey(key))
+	line.WriteString("=")
+	line.WriteString(logfmtValue(value))
+}
+
+// logfmtKey replaces the characters of the given key that are not valid in a logfmt key with '_'.
+func logfmtKey(key string) string {
+	return strings.Map(func(r rune) rune {
+		if r <= ' ' || r == '=' || r == '"' {
+			return '_'
+		}
+
+		return r
+	}, key)
+}
+
+// logfmtValue quotes the given value if it is empty, or contains whitespace, quotes, '=', or control characters.
+func logfmtValue(value string) string {
+	if value == "" || strings.ContainsFunc(value, func(r rune) bool {
+		return r <= ' ' || r == '=' || r == '"' || r == 0x7f
+	}) {
+		return strconv.Quote(value)
+	}
+
+	return value
+}
@@ -0,0 +1,284 @@
+package bobotel
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploggrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploghttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdoutlog"
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/log/global"
+	"go.opentelemetry.io/otel/log/noop"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// ErrLoggerProviderAlreadyInitialized is returned by InitializeLoggerProvider when a logger provider with exporters is
+// already active.
+var ErrLoggerProviderAlreadyInitialized = errors.New("logger provider already initialized")
+
+var (
+	loggerProviderLock      sync.RWMutex
+	singletonLoggerProvider log.LoggerProvider
+	loggerConfigLock        sync.RWMutex
+	defaultLoggerConfig     *LoggerConfig
+)
+
+// NewLogger creates an open-telemetry logger with the given name and options. NewLogger must be called after
+// InitializeLoggerProvider in order to not receive a no-op logger. Log records emitted with a context containing a span
+// are correlated with the span's trace and span IDs.
+func NewLogger(loggerName string, options ...log.LoggerOption) log.Logger {
+	loggerProviderLock.RLock()
+	defer loggerProviderLock.RUnlock()
+
+	if singletonLoggerProvider != nil {
+		return singletonLoggerProvider.Logger(loggerName, options...)
+	} else {
+		return NewNoopLogger(loggerName, options...)
+	}
+}
+
+// NewNoopLogger creates a no-op logger with the given name.
+func NewNoopLogger(loggerName string, options ...log.LoggerOption) log.Logger {
+	return noop.NewLoggerProvider().Logger(loggerName, options...)
+}
+
+// InitializeLoggerProvider initializes an open-telemetry logger provider configured via the given LoggerConfig. The
+// logger provider is registered as the global logger provider, which is used by open-telemetry log bridges (e.g.
+// otelslog and otelzap).
+//
+// InitializeLoggerProvider returns ErrLoggerProviderAlreadyInitialized if a previously initialized logger provider with
+// exporters has not been shut down via ShutdownLoggerProvider, which prevents the previous provider from being leaked.
+// A previously initialized no-op logger provider is replaced.
+func InitializeLoggerProvider(config ...*LoggerConfig) (err error) {
+	var c *LoggerConfig
+
+	if len(config) > 0 {
+		c = config[0]
+	} else {
+		loggerConfigLock.RLock()
+		defer loggerConfigLock.RUnlock()
+
+		c = defaultLoggerConfig
+	}
+
+	if c == nil {
+		return errors.New("no logger provider configuration provided or found")
+	}
+
+	loggerProviderLock.Lock()
+	defer loggerProviderLock.Unlock()
+
+	if _, ok := singletonLoggerProvider.(*sdklog.LoggerProvider); ok {
+		return ErrLoggerProviderAlreadyInitialized
+	}
+
+	providerResource, err := newProviderResource(context.Background(), resourceConfig{
+		appName:            c.AppName,
+		appID:              c.AppID,
+		serviceVersion:     c.ServiceVersion,
+		serviceNamespace:   c.OtelServiceNamespace,
+		resourceAttributes: c.ResourceAttributes,
+		minimal:            c.OtelMinimalResource,
+	})
+	if err != nil {
+		return fmt.Errorf("problem creating logger provider resources: %w", err)
+	}
+
+	opts := []sdklog.LoggerProviderOption{sdklog.WithResource(providerResource)}
+
+	if len(c.OtelLogExporters) < 1 {
+		singletonLoggerProvider = noop.NewLoggerProvider()
+
+		return nil
+	}
+
+	var exporters []sdklog.Exporter
+
+	// NOTE: exporters created before an error are shut down with a background context, so that they are not leaked
+	defer func() {
+		if err != nil {
+			for _, exporter := range exporters {
+				_ = exporter.Shutdown(context.Background())
+			}
+		}
+	}()
+
+	for _, exporter := range c.OtelLogExporters {
+		switch exporter {
+		case "console":
+			consoleExporter, err := newConsoleLogExporter(c)
+			if err != nil {
+				return fmt.Errorf("problem creating logger console exporter: %w", err)
+			}
+
+			exporters = append(exporters, consoleExporter)
+
+			opts = append(opts, sdklog.WithProcessor(sdklog.NewBatchProcessor(consoleExporter)))
+		case "otlp":
+			otlpExporter, err := newOtlpLogExporter(c)
+			if err != nil {
+				return fmt.Errorf("problem creating logger otlp exporter: %w", err)
+			}
+
+			exporters = append(exporters, otlpExporter)
+
+			opts = append(opts, sdklog.WithProcessor(sdklog.NewBatchProcessor(otlpExporter)))
+		default:
+			return fmt.Errorf("unsupported exporter found: %s", exporter)
+		}
+	}
+
+	provider := sdklog.NewLoggerProvider(opts...)
+	singletonLoggerProvider = provider
+
+	global.SetLoggerProvider(provider)
+
+	return nil
+}
+
+// ShutdownLoggerProvider flushes any pending log records and shuts down the logger provider, after which a logger
+// provider can be initialized again via InitializeLoggerProvider.
+func ShutdownLoggerProvider(ctx context.Context) error {
+	loggerProviderLock.Lock()
+	defer loggerProviderLock.Unlock()
+
+	if sdkLoggerProvider, ok := singletonLoggerProvider.(*sdklog.LoggerProvider); ok {
+		_ = sdkLoggerProvider.ForceFlush(ctx)
+
+		// NOTE: the provider is released even if shutdown fails, as a shut down provider can't be shut down again
+		singletonLoggerProvider = nil
+
+		if err := sdkLoggerProvider.Shutdown(ctx); err != nil {
+			return fmt.Errorf("problem shutting down logger provider: %w", err)
+		}
+
+		return nil
+	}
+
+	return nil
+}
+
+func newConsoleLogExporter(c *LoggerConfig) (sdklog.Exporter, error) {
+	// NOTE: logfmt output is only supported for traces, so the 'logfmt' format outputs single-line JSON objects
+	if c.OtelConsoleFormat == "production" || c.OtelConsoleFormat == "json" || c.OtelConsoleFormat == "logfmt" {
+		return stdoutlog.New(
+			stdoutlog.WithWriter(newConsoleOutputWriter(c.OtelConsoleOutput)),
+		)
+	}
+
+	return stdoutlog.New(
+		stdoutlog.WithWriter(newConsoleOutputWriter(c.OtelConsoleOutput)),
+		stdoutlog.WithPrettyPrint(),
+	)
+}
+
+// otlpConfig returns a Config with the otlp fields of the LoggerConfig, so that the otlp log exporter is configured via
+// the same otlp settings as the trace exporter.
+func (c *LoggerConfig) otlpConfig() *Config {
+	return &Config{
+		OtlpEndpointKind:         c.OtlpEndpointKind,
+		OtlpEndpointURL:          c.OtlpEndpointURL,
+		OtlpHost:                 c.OtlpHost,
+		OtlpPort:                 c.OtlpPort,
+		OtlpCompression:          c.OtlpCompression,
+		OtlpTimeout:              c.OtlpTimeout,
+		OtlpRetryDisabled:        c.OtlpRetryDisabled,
+		OtlpRetryInitialInterval: c.OtlpRetryInitialInterval,
+		OtlpRetryMaxInterval:     c.OtlpRetryMaxInterval,
+		OtlpRetryMaxElapsedTime:  c.OtlpRetryMaxElapsedTime,
+		OtlpUserAgent:            c.OtlpUserAgent,
+		OtlpInsecure:             c.OtlpInsecure,
+		OtlpTLSEnabled:           c.OtlpTLSEnabled,
+		OtlpCACertPath:           c.OtlpCACertPath,
+		OtlpClientCertPath:       c.OtlpClientCertPath,
+		OtlpClientKeyPath:        c.OtlpClientKeyPath,
+		OtlpHeaders:              c.OtlpHeaders,
+	}
+}
+
+func newOtlpLogExporter(c *LoggerConfig) (sdklog.Exporter, error) {
+	settings, err := newOtlpExportSettings(c.otlpConfig(), "/v1/logs")
+	if err != nil {
+		return nil, err
+	}
+
+	var exporter sdklog.Exporter
+
+	switch c.OtlpEndpointKind {
+	case "http":
+		opts := []otlploghttp.Option{
+			otlploghttp.WithEndpointURL(settings.endpointURL),
+			otlploghttp.WithRetry(otlploghttp.RetryConfig{
+				Enabled:         settings.retryEnabled,
+				InitialInterval: settings.initialInterval,
+				MaxInterval:     settings.maxInterval,
+				MaxElapsedTime:  settings.maxElapsedTime,
+			}),
+		}
+
+		if settings.tlsConfig != nil {
+			opts = append(opts, otlploghttp.WithTLSClientConfig(settings.tlsConfig))
+		}
+
+		if len(settings.httpHeaders) > 0 {
+			opts = append(opts, otlploghttp.WithHeaders(settings.httpHeaders))
+		}
+
+		if settings.timeout > 0 {
+			opts = append(opts, otlploghttp.WithTimeout(settings.timeout))
+		}
+
+		if settings.gzip {
+			opts = append(opts, otlploghttp.WithCompression(otlploghttp.GzipCompression))
+		} else {
+			opts = append(opts, otlploghttp.WithCompression(otlploghttp.NoCompression))
+		}
+
+		exporter, err = otlploghttp.New(context.Background(), opts...)
+	case "grpc":
+		opts := []otlploggrpc.Option{
+			otlploggrpc.WithEndpoint(settings.endpoint),
+			otlploggrpc.WithRetry(otlploggrpc.RetryConfig{
+				Enabled:         settings.retryEnabled,
+				InitialInterval: settings.initialInterval,
+				MaxInterval:     settings.maxInterval,
+				MaxElapsedTime:  settings.maxElapsedTime,
+			}),
+		}
+
+		if settings.insecure {
+			opts = append(opts, otlploggrpc.WithInsecure())
+		} else {
+			opts = append(opts, otlploggrpc.WithTLSCredentials(credentials.NewTLS(settings.tlsConfig)))
+		}
+
+		if settings.userAgent != "" {
+			opts = append(opts, otlploggrpc.WithDialOption(grpc.WithUserAgent(settings.userAgent)))
+		}
+
+		if len(settings.grpcHeaders) > 0 {
+			opts = append(opts, otlploggrpc.WithHeaders(settings.grpcHeaders))
+		}
+
+		if settings.timeout > 0 {
+			opts = append(opts, otlploggrpc.WithTimeout(settings.timeout))
+		}
+
+		if settings.gzip {
+			opts = append(opts, otlploggrpc.WithCompressor("gzip"))
+		}
+
+		exporter, err = otlploggrpc.New(context.Background(), opts...)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("problem creating otlp log exporter: %w", err)
+	}
+
+	return exporter, nil
+}
@@ -0,0 +1,66 @@
+package bobotel
+
+import (
+	"context"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+// InMemoryExporter is a span exporter that stores exported spans in memory, which is intended for asserting on
+// emitted spans in tests. An InMemoryExporter is created by InitializeTraceProvider when the 'memory' exporter is
+// configured.
+type InMemoryExporter struct {
+	exporter *tracetest.InMemoryExporter
+}
+
+// NewInMemoryExporter creates an empty InMemoryExporter.
+func NewInMemoryExporter() *InMemoryExporter {
+	return &InMemoryExporter{exporter: tracetest.NewInMemoryExporter()}
+}
+
+// ExportSpans stores the given spans in memory.
+func (e *InMemoryExporter) ExportSpans(ctx context.Context, spans []sdktrace.ReadOnlySpan) error {
+	return e.exporter.ExportSpans(ctx, spans)
+}
+
+// Shutdown stops the exporter, clearing any stored spans.
+func (e *InMemoryExporter) Shutdown(ctx context.Context) error {
+	return e.exporter.Shutdown(ctx)
+}
+
+// GetRecordedSpans returns a copy of the spans exported so far.
+func (e *InMemoryExporter) GetRecordedSpans() tracetest.SpanStubs {
+	return e.exporter.GetSpans()
+}
+
+// Reset clears the spans exported so far.
+func (e *InMemoryExporter) Reset() {
+	e.exporter.Reset()
+}
+
+// GetRecordedSpans returns the spans recorded by the in-memory exporter of the current trace provider. Nil is returned
+// if the trace provider was not initialized with the 'memory' exporter.
+func GetRecordedSpans() tracetest.SpanStubs {
+	traceProviderLock.RLock()
+	defer traceProviderLock.RUnlock()
+
+	if singletonProvider == nil {
+		return nil
+	}
+
+	return singletonProvider.GetRecordedSpans()
+}
+
+// GetInMemoryExporter returns the in-memory exporter of the current trace provider. Nil is returned if the trace
+// provider was not initialized with the 'memory' exporter.
+func GetInMemoryExporter() *InMemoryExporter {
+	traceProviderLock.RLock()
+	defer traceProviderLock.RUnlock()
+
+	if singletonProvider == nil {
+		return nil
+	}
+
+	return singletonProvider.GetInMemoryExporter()
+}
@@ -0,0 +1,279 @@
+package bobotel
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdoutmetric"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/noop"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+// ErrMeterProviderAlreadyInitialized is returned by InitializeMeterProvider when a meter provider with exporters is
+// already active.
+var ErrMeterProviderAlreadyInitialized = errors.New("meter provider already initialized")
+
+var (
+	meterProviderLock      sync.RWMutex
+	singletonMeterProvider metric.MeterProvider
+	meterConfigLock        sync.RWMutex
+	defaultMeterConfig     *MeterConfig
+)
+
+// NewMeter creates an open-telemetry meter with the given name and options. NewMeter must be called after
+// InitializeMeterProvider in order to not receive a no-op meter.
+func NewMeter(meterName string, options ...metric.MeterOption) metric.Meter {
+	meterProviderLock.RLock()
+	defer meterProviderLock.RUnlock()
+
+	if singletonMeterProvider != nil {
+		return singletonMeterProvider.Meter(meterName, options...)
+	} else {
+		return NewNoopMeter(meterName, options...)
+	}
+}
+
+// NewNoopMeter creates a no-op meter with the given name.
+func NewNoopMeter(meterName string, options ...metric.MeterOption) metric.Meter {
+	return noop.NewMeterProvider().Meter(meterName, options...)
+}
+
+// InitializeMeterProvider initializes an open-telemetry meter provider configured via the given MeterConfig.
+//
+// InitializeMeterProvider returns ErrMeterProviderAlreadyInitialized if a previously initialized meter provider with
+// exporters has not been shut down via ShutdownMeterProvider, which prevents the previous provider from being leaked.
+// A previously initialized no-op meter provider is replaced.
+func InitializeMeterProvider(config ...*MeterConfig) (err error) {
+	var c *MeterConfig
+
+	if len(config) > 0 {
+		c = config[0]
+	} else {
+		meterConfigLock.RLock()
+		defer meterConfigLock.RUnlock()
+
+		c = defaultMeterConfig
+	}
+
+	if c == nil {
+		return errors.New("no meter provider configuration provided or found")
+	}
+
+	meterProviderLock.Lock()
+	defer meterProviderLock.Unlock()
+
+	if _, ok := singletonMeterProvider.(*sdkmetric.MeterProvider); ok {
+		return ErrMeterProviderAlreadyInitialized
+	}
+
+	providerResource, err := newProviderResource(
+		context.Background(), resourceConfig{appName: c.AppName, appID: c.AppID, minimal: c.OtelMinimalResource},
+	)
+	if err != nil {
+		return fmt.Errorf("problem creating meter provider resources: %w", err)
+	}
+
+	opts := []sdkmetric.Option{sdkmetric.WithResource(providerResource)}
+
+	if len(c.OtelMetricExporters) < 1 {
+		singletonMeterProvider = noop.NewMeterProvider()
+
+		return nil
+	}
+
+	var exporters []sdkmetric.Exporter
+
+	// NOTE: exporters created before an error are shut down with a background context, so that they are not leaked
+	defer func() {
+		if err != nil {
+			for _, exporter := range exporters {
+				_ = exporter.Shutdown(context.Background())
+			}
+		}
+	}()
+
+	for _, exporter := range c.OtelMetricExporters {
+		switch exporter {
+		case "console":
+			consoleExporter, err := newConsoleMetricExporter(c)
+			if err != nil {
+				return fmt.Errorf("problem creating meter console exporter: %w", err)
+			}
+
+			exporters = append(exporters, consoleExporter)
+
+			opts = append(opts, sdkmetric.WithReader(sdkmetric.NewPeriodicReader(consoleExporter)))
+		case "otlp":
+			otlpExporter, err := newOtlpMetricExporter(c)
+			if err != nil {
+				return fmt.Errorf("problem creating meter otlp exporter: %w", err)
+			}
+
+			exporters = append(exporters, otlpExporter)
+
+			opts = append(opts, sdkmetric.WithReader(sdkmetric.NewPeriodicReader(otlpExporter)))
+		default:
+			return fmt.Errorf("unsupported exporter found: %s", exporter)
+		}
+	}
+
+	provider := sdkmetric.NewMeterProvider(opts...)
+	singletonMeterProvider = provider
+
+	// Register as the global OTEL meter provider so callers using
+	// otel.Meter() (not just bobotel.NewMeter()) get real instruments.
+	otel.SetMeterProvider(provider)
+
+	return nil
+}
+
+// ShutdownMeterProvider flushes any pending metrics and shuts down the meter provider, after which a meter provider
+// can be initialized again via InitializeMeterProvider.
+func ShutdownMeterProvider(ctx context.Context) error {
+	meterProviderLock.Lock()
+	defer meterProviderLock.Unlock()
+
+	if sdkMeterProvider, ok := singletonMeterProvider.(*sdkmetric.MeterProvider); ok {
+		_ = sdkMeterProvider.ForceFlush(ctx)
+
+		// NOTE: the provider is released even if shutdown fails, as a shut down provider can't be shut down again
+		singletonMeterProvider = nil
+
+		if err := sdkMeterProvider.Shutdown(ctx); err != nil {
+			return fmt.Errorf("problem shutting down meter provider: %w", err)
+		}
+
+		return nil
+	}
+
+	return nil
+}
+
+func newConsoleMetricExporter(c *MeterConfig) (sdkmetric.Exporter, error) {
+	// NOTE: the json encoder used by the exporter outputs a single-line JSON object per write unless pretty-printed,
+	// and logfmt output is only supported for traces, so the 'logfmt' format outputs single-line JSON objects
+	if c.OtelConsoleFormat == "production" || c.OtelConsoleFormat == "json" || c.OtelConsoleFormat == "logfmt" {
+		return stdoutmetric.New(
+			stdoutmetric.WithWriter(newConsoleOutputWriter(c.OtelConsoleOutput)),
+		)
+	}
+
+	return stdoutmetric.New(
+		stdoutmetric.WithWriter(newConsoleOutputWriter(c.OtelConsoleOutput)),
+		stdoutmetric.WithPrettyPrint(),
+	)
+}
+
+// otlpConfig returns a Config with the otlp fields of the MeterConfig, so that the otlp metric exporter is configured
+// via the same otlp settings as the trace exporter.
+func (c *MeterConfig) otlpConfig() *Config {
+	return &Config{
+		OtlpEndpointKind:         c.OtlpEndpointKind,
+		OtlpEndpointURL:          c.OtlpEndpointURL,
+		OtlpHost:                 c.OtlpHost,
+		OtlpPort:                 c.OtlpPort,
+		OtlpCompression:          c.OtlpCompression,
+		OtlpTimeout:              c.OtlpTimeout,
+		OtlpRetryDisabled:        c.OtlpRetryDisabled,
+		OtlpRetryInitialInterval: c.OtlpRetryInitialInterval,
+		OtlpRetryMaxInterval:     c.OtlpRetryMaxInterval,
+		OtlpRetryMaxElapsedTime:  c.OtlpRetryMaxElapsedTime,
+		OtlpUserAgent:            c.OtlpUserAgent,
+		OtlpInsecure:             c.OtlpInsecure,
+		OtlpTLSEnabled:           c.OtlpTLSEnabled,
+		OtlpCACertPath:           c.OtlpCACertPath,
+		OtlpClientCertPath:       c.OtlpClientCertPath,
+		OtlpClientKeyPath:        c.OtlpClientKeyPath,
+		OtlpHeaders:              c.OtlpHeaders,
+	}
+}
+
+func newOtlpMetricExporter(c *MeterConfig) (sdkmetric.Exporter, error) {
+	settings, err := newOtlpExportSettings(c.otlpConfig(), "/v1/metrics")
+	if err != nil {
+		return nil, err
+	}
+
+	var exporter sdkmetric.Exporter
+
+	switch c.OtlpEndpointKind {
+	case "http":
+		opts := []otlpmetrichttp.Option{
+			otlpmetrichttp.WithEndpointURL(settings.endpointURL),
+			otlpmetrichttp.WithRetry(otlpmetrichttp.RetryConfig{
+				Enabled:         settings.retryEnabled,
+				InitialInterval: settings.initialInterval,
+				MaxInterval:     settings.maxInterval,
+				MaxElapsedTime:  settings.maxElapsedTime,
+			}),
+		}
+
+		if settings.tlsConfig != nil {
+			opts = append(opts, otlpmetrichttp.WithTLSClientConfig(settings.tlsConfig))
+		}
+
+		if len(settings.httpHeaders) > 0 {
+			opts = append(opts, otlpmetrichttp.WithHeaders(settings.httpHeaders))
+		}
+
+		if settings.timeout > 0 {
+			opts = append(opts, otlpmetrichttp.WithTimeout(settings.timeout))
+		}
+
+		if settings.gzip {
+			opts = append(opts, otlpmetrichttp.WithCompression(otlpmetrichttp.GzipCompression))
+		} else {
+			opts = append(opts, otlpmetrichttp.WithCompression(otlpmetrichttp.NoCompression))
+		}
+
+		exporter, err = otlpmetrichttp.New(context.Background(), opts...)
+	case "grpc":
+		opts := []otlpmetricgrpc.Option{
+			otlpmetricgrpc.WithEndpoint(settings.endpoint),
+			otlpmetricgrpc.WithRetry(otlpmetricgrpc.RetryConfig{
+				Enabled:         settings.retryEnabled,
+				InitialInterval: settings.initialInterval,
+				MaxInterval:     settings.maxInterval,
+				MaxElapsedTime:  settings.maxElapsedTime,
+			}),
+		}
+
+		if settings.insecure {
+			opts = append(opts, otlpmetricgrpc.WithInsecure())
+		} else {
+			opts = append(opts, otlpmetricgrpc.WithTLSCredentials(credentials.NewTLS(settings.tlsConfig)))
+		}
+
+		if settings.userAgent != "" {
+			opts = append(opts, otlpmetricgrpc.WithDialOption(grpc.WithUserAgent(settings.userAgent)))
+		}
+
+		if len(settings.grpcHeaders) > 0 {
+			opts = append(opts, otlpmetricgrpc.WithHeaders(settings.grpcHeaders))
+		}
+
+		if settings.timeout > 0 {
+			opts = append(opts, otlpmetricgrpc.WithTimeout(settings.timeout))
+		}
+
+		if settings.gzip {
+			opts = append(opts, otlpmetricgrpc.WithCompressor("gzip"))
+		}
+
+		exporter, err = otlpmetricgrpc.New(context.Background(), opts...)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("problem creating otlp metric exporter: %w", err)
+	}
+
+	return exporter, nil
+}
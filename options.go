@@ -0,0 +1,79 @@
+package bobotel
+
+import (
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// Option customizes the trace provider created by InitializeTraceProviderWithOptions or NewProvider beyond the fields
+// exposed by Config, e.g. for configuring open-telemetry sdk components directly. A *Config is also an Option, which
+// sets the Config that the trace provider is configured via, where the last Config provided is used.
+type Option interface {
+	applyOption(o *providerOptions)
+}
+
+type providerOptions struct {
+	config                *Config
+	configProvided        bool
+	resource              *resource.Resource
+	sampler               sdktrace.Sampler
+	spanProcessors        []sdktrace.SpanProcessor
+	tracerProviderOptions []sdktrace.TracerProviderOption
+}
+
+func newProviderOptions(opts []Option) providerOptions {
+	options := providerOptions{}
+
+	for _, opt := range opts {
+		if opt != nil {
+			opt.applyOption(&options)
+		}
+	}
+
+	return options
+}
+
+type optionFunc func(o *providerOptions)
+
+func (f optionFunc) applyOption(o *providerOptions) {
+	f(o)
+}
+
+func (c *Config) applyOption(o *providerOptions) {
+	o.config = c
+	o.configProvided = true
+}
+
+// WithResource returns an Option that merges the given resource into the resource configured via Config, where the
+// attributes of the given resource take precedence.
+func WithResource(r *resource.Resource) Option {
+	return optionFunc(func(o *providerOptions) {
+		o.resource = r
+	})
+}
+
+// WithSampler returns an Option that sets the sampler used instead of the sampler configured via Config, including any
+// sampler rules. The sampling ratio of a trace provider configured with WithSampler cannot be updated via
+// SetSamplingRatio.
+func WithSampler(sampler sdktrace.Sampler) Option {
+	return optionFunc(func(o *providerOptions) {
+		o.sampler = sampler
+	})
+}
+
+// WithSpanProcessor returns an Option that registers the given span processor in addition to the span processors of
+// the configured exporters, e.g. for exporting to a custom batcher. A trace provider configured with a span processor
+// is backed by the open-telemetry sdk even when no exporters are configured.
+func WithSpanProcessor(processor sdktrace.SpanProcessor) Option {
+	return optionFunc(func(o *providerOptions) {
+		o.spanProcessors = append(o.spanProcessors, processor)
+	})
+}
+
+// WithTracerProviderOptions returns an Option that applies the given open-telemetry sdk trace provider options after
+// the options configured via Config, which take precedence over the configured options.
+func WithTracerProviderOptions(opts ...sdktrace.TracerProviderOption) Option {
+	return optionFunc(func(o *providerOptions) {
+		o.tracerProviderOptions = append(o.tracerProviderOptions, opts...)
+	})
+}
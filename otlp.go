@@ -0,0 +1,349 @@
+package bobotel
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"maps"
+	"net/url"
+	"os"
+	"slices"
+	"time"
+
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+	"google.golang.org/grpc/credentials"
+)
+
+const (
+	// NOTE: retry defaults match the open-telemetry otlp exporter defaults
+	defaultOtlpRetryInitialInterval = 5 * time.Second
+	defaultOtlpRetryMaxInterval     = 30 * time.Second
+	defaultOtlpRetryMaxElapsedTime  = time.Minute
+	// NOTE: matches the default timeout of the open-telemetry otlp exporters
+	defaultOtlpConnectTimeout = 10 * time.Second
+)
+
+// otlpEndpointURLSchemes defines the accepted endpoint url schemes for each otlp endpoint kind.
+var otlpEndpointURLSchemes = map[string][]string{
+	"http": {"http", "https"},
+	"grpc": {"grpc", "grpcs", "http", "https"},
+}
+
+func parseOtlpEndpointURL(endpointURL string) (*url.URL, error) {
+	parsedURL, err := url.Parse(endpointURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid otlp endpoint url: %w", err)
+	}
+
+	if parsedURL.Scheme == "" || parsedURL.Host == "" {
+		return nil, fmt.Errorf("invalid otlp endpoint url: '%s', expected a scheme and host", endpointURL)
+	}
+
+	return parsedURL, nil
+}
+
+// parseOtlpEndpointURLForKind parses the given endpoint url, and validates that its scheme is accepted by the given
+// endpoint kind.
+func parseOtlpEndpointURLForKind(endpointKind, endpointURL string) (*url.URL, error) {
+	parsedURL, err := parseOtlpEndpointURL(endpointURL)
+	if err != nil {
+		return nil, err
+	}
+
+	if !slices.Contains(otlpEndpointURLSchemes[endpointKind], parsedURL.Scheme) {
+		return nil, fmt.Errorf(
+			"otlp endpoint url scheme '%s' is not supported by endpoint kind '%s'", parsedURL.Scheme, endpointKind,
+		)
+	}
+
+	return parsedURL, nil
+}
+
+// otlpEndpointURLSecure returns whether the given endpoint url scheme requires a secure connection.
+func otlpEndpointURLSecure(endpointURL *url.URL) bool {
+	return endpointURL.Scheme == "https" || endpointURL.Scheme == "grpcs"
+}
+
+// otlpEnvEndpointURL returns the endpoint url set via the standard otlp environment variables, where the
+// OTEL_EXPORTER_OTLP_TRACES_ENDPOINT url is used as-is, and the OTEL_EXPORTER_OTLP_ENDPOINT base url is joined with
+// the given url path (e.g. '/v1/traces' for 'http' endpoints). An empty string is returned if neither is set.
+func otlpEnvEndpointURL(urlPath string) string {
+	if endpointURL := os.Getenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT"); endpointURL != "" {
+		return endpointURL
+	}
+
+	endpointURL := os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT")
+	if endpointURL == "" || urlPath == "" {
+		return endpointURL
+	}
+
+	parsedURL, err := url.Parse(endpointURL)
+	if err != nil {
+		// NOTE: the invalid url is returned as-is, so that parsing errors are surfaced when creating the exporter
+		return endpointURL
+	}
+
+	return parsedURL.JoinPath(urlPath).String()
+}
+
+// otlpEnvConfig returns the given config with the endpoint url set via the standard otlp environment variables when
+// neither the endpoint url nor host is configured, and otherwise returns the given config.
+func otlpEnvConfig(c *Config) *Config {
+	if c.OtlpEndpointURL != "" || c.OtlpHost != "" {
+		return c
+	}
+
+	urlPath := ""
+	if c.OtlpEndpointKind == "http" {
+		urlPath = "/v1/traces"
+	}
+
+	endpointURL := otlpEnvEndpointURL(urlPath)
+	if endpointURL == "" {
+		return c
+	}
+
+	envConfig := *c
+	envConfig.OtlpEndpointURL = endpointURL
+
+	return &envConfig
+}
+
+// otlpInsecure returns whether otlp exports are sent without transport security, which is the case when otlp insecure
+// is enabled, or when the configured endpoint url has an insecure scheme. An error is returned for conflicting
+// settings.
+func otlpInsecure(c *Config) (bool, error) {
+	insecure := c.OtlpInsecure
+
+	if c.OtlpEndpointURL != "" {
+		endpointURL, err := parseOtlpEndpointURLForKind(c.OtlpEndpointKind, c.OtlpEndpointURL)
+		if err != nil {
+			return false, err
+		}
+
+		secure := otlpEndpointURLSecure(endpointURL)
+		if secure && c.OtlpInsecure {
+			return false, fmt.Errorf("otlp insecure cannot be enabled for secure endpoint url: %s", endpointURL)
+		}
+
+		insecure = !secure
+	}
+
+	if insecure && c.OtlpTLSEnabled {
+		return false, errors.New("otlp tls cannot be enabled for an insecure otlp endpoint")
+	}
+
+	return insecure, nil
+}
+
+// otlpHTTPEndpointURL returns the configured endpoint url, or an endpoint url built from the configured host and port
+// with the given url path.
+func otlpHTTPEndpointURL(c *Config, insecure bool, urlPath string) string {
+	if c.OtlpEndpointURL != "" {
+		return c.OtlpEndpointURL
+	}
+
+	scheme := "https"
+	if insecure {
+		scheme = "http"
+	}
+
+	host := fmt.Sprintf("%s:%d", c.OtlpHost, otlpPort(c.OtlpEndpointKind, c.OtlpPort))
+
+	return (&url.URL{Scheme: scheme, Host: host, Path: urlPath}).String()
+}
+
+// otlpPort returns the given port, or the default port of the given endpoint kind when the port is unset (4317 for
+// 'grpc' and 4318 otherwise).
+func otlpPort(endpointKind string, port int) int {
+	if port > 0 {
+		return port
+	}
+
+	if endpointKind == "grpc" {
+		return 4317
+	}
+
+	return 4318
+}
+
+// otlpRetryIntervals returns the configured retry intervals, falling back to the exporter defaults for unset values.
+func otlpRetryIntervals(c *Config) (initialInterval, maxInterval, maxElapsedTime time.Duration) {
+	initialInterval, maxInterval, maxElapsedTime =
+		c.OtlpRetryInitialInterval, c.OtlpRetryMaxInterval, c.OtlpRetryMaxElapsedTime
+
+	if initialInterval <= 0 {
+		initialInterval = defaultOtlpRetryInitialInterval
+	}
+
+	if maxInterval <= 0 {
+		maxInterval = defaultOtlpRetryMaxInterval
+	}
+
+	if maxElapsedTime <= 0 {
+		maxElapsedTime = defaultOtlpRetryMaxElapsedTime
+	}
+
+	return initialInterval, maxInterval, maxElapsedTime
+}
+
+// otlpUserAgent returns the configured otlp user-agent, or the open-telemetry otlp exporter user-agent when unset.
+func otlpUserAgent(c *Config) string {
+	if c.OtlpUserAgent != "" {
+		return c.OtlpUserAgent
+	}
+
+	return "OTel OTLP Exporter Go/" + otlptrace.Version()
+}
+
+// otlpHTTPHeaders returns the configured otlp headers, including the 'User-Agent' header when a user-agent is
+// configured.
+func otlpHTTPHeaders(c *Config) map[string]string {
+	if c.OtlpUserAgent == "" {
+		return c.OtlpHeaders
+	}
+
+	headers := maps.Clone(c.OtlpHeaders)
+	if headers == nil {
+		headers = map[string]string{}
+	}
+
+	headers["User-Agent"] = c.OtlpUserAgent
+
+	return headers
+}
+
+// otlpEndpointConfig returns a copy of the given config with the primary otlp endpoint replaced by the given endpoint.
+func otlpEndpointConfig(c *Config, endpoint OtlpEndpoint) *Config {
+	endpointConfig := *c
+
+	if endpoint.Kind != "" {
+		endpointConfig.OtlpEndpointKind = endpoint.Kind
+	}
+
+	if endpoint.Port > 0 {
+		endpointConfig.OtlpPort = endpoint.Port
+	}
+
+	endpointConfig.OtlpHost = endpoint.Host
+	endpointConfig.OtlpEndpointURL = endpoint.URL
+	endpointConfig.OtlpHeaders = endpoint.Headers
+	endpointConfig.OtlpEndpoints = nil
+
+	return &endpointConfig
+}
+
+// waitForOtlpGRPCConnection blocks until a grpc connection to the given endpoint is ready, bounded by the given
+// context and the otlp timeout. The open-telemetry grpc exporter ignores the grpc.WithBlock dial option, and connects
+// lazily, so the endpoint is verified to be reachable via a separate connection that is closed once ready.
+func waitForOtlpGRPCConnection(
+	ctx context.Context, c *Config, endpoint string, transportCredentials credentials.TransportCredentials,
+) error {
+	timeout := c.OtlpTimeout
+	if timeout <= 0 {
+		timeout = defaultOtlpConnectTimeout
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	dialOptions := append(slices.Clone(c.OtlpGRPCDialOptions), grpc.WithTransportCredentials(transportCredentials))
+
+	conn, err := grpc.NewClient(endpoint, dialOptions...)
+	if err != nil {
+		return fmt.Errorf("problem connecting to otlp grpc endpoint '%s': %w", endpoint, err)
+	}
+
+	defer conn.Close()
+
+	conn.Connect()
+
+	for state := conn.GetState(); state != connectivity.Ready; state = conn.GetState() {
+		if !conn.WaitForStateChange(ctx, state) {
+			return fmt.Errorf("problem connecting to otlp grpc endpoint '%s': %w", endpoint, ctx.Err())
+		}
+	}
+
+	return nil
+}
+
+// otlpExportSettings defines the otlp exporter settings shared by the metric and log exporters, which are built from
+// the same otlp fields as the trace exporter so that every signal is exported with the same transport, headers, and
+// retry behavior.
+type otlpExportSettings struct {
+	endpoint        string
+	endpointURL     string
+	insecure        bool
+	tlsConfig       *tls.Config
+	httpHeaders     map[string]string
+	grpcHeaders     map[string]string
+	userAgent       string
+	timeout         time.Duration
+	retryEnabled    bool
+	initialInterval time.Duration
+	maxInterval     time.Duration
+	maxElapsedTime  time.Duration
+	gzip            bool
+}
+
+// newOtlpExportSettings returns the otlp exporter settings of the given config, where the given url path is used for
+// 'http' endpoints built from the configured host and port (e.g. '/v1/metrics').
+func newOtlpExportSettings(c *Config, urlPath string) (otlpExportSettings, error) {
+	if c.OtlpEndpointKind != "http" && c.OtlpEndpointKind != "grpc" {
+		return otlpExportSettings{}, fmt.Errorf("unsupported otlp endpoint kind: %s", c.OtlpEndpointKind)
+	}
+
+	if c.OtlpEndpointURL == "" && c.OtlpHost == "" {
+		return otlpExportSettings{}, errors.New("no otlp host or endpoint url provided")
+	}
+
+	insecure, err := otlpInsecure(c)
+	if err != nil {
+		return otlpExportSettings{}, err
+	}
+
+	settings := otlpExportSettings{
+		endpoint:     fmt.Sprintf("%s:%d", c.OtlpHost, otlpPort(c.OtlpEndpointKind, c.OtlpPort)),
+		endpointURL:  otlpHTTPEndpointURL(c, insecure, urlPath),
+		insecure:     insecure,
+		httpHeaders:  otlpHTTPHeaders(c),
+		grpcHeaders:  c.OtlpHeaders,
+		userAgent:    c.OtlpUserAgent,
+		timeout:      c.OtlpTimeout,
+		retryEnabled: !c.OtlpRetryDisabled,
+	}
+
+	settings.initialInterval, settings.maxInterval, settings.maxElapsedTime = otlpRetryIntervals(c)
+
+	if c.OtlpEndpointURL != "" {
+		endpointURL, err := parseOtlpEndpointURLForKind(c.OtlpEndpointKind, c.OtlpEndpointURL)
+		if err != nil {
+			return otlpExportSettings{}, err
+		}
+
+		settings.endpoint = endpointURL.Host
+	}
+
+	if !insecure {
+		settings.tlsConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+
+		if c.OtlpTLSEnabled {
+			if settings.tlsConfig, err = newTLSConfig(c); err != nil {
+				return otlpExportSettings{}, fmt.Errorf("problem creating otlp tls configuration: %w", err)
+			}
+		}
+	}
+
+	switch c.OtlpCompression {
+	case "", "gzip":
+		settings.gzip = true
+	case "none":
+	default:
+		return otlpExportSettings{}, fmt.Errorf("unsupported otlp compression: %s", c.OtlpCompression)
+	}
+
+	return settings, nil
+}
@@ -0,0 +1,279 @@
+package bobotel
+
+import (
+	"testing"
+	"time"
+)
+
+func TestOtlpInsecure(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   *Config
+		expected bool
+		wantErr  bool
+	}{
+		{
+			name:     "secure by default",
+			config:   &Config{OtlpEndpointKind: "http", OtlpHost: "collector"},
+			expected: false,
+		},
+		{
+			name:     "insecure enabled",
+			config:   &Config{OtlpEndpointKind: "http", OtlpHost: "collector", OtlpInsecure: true},
+			expected: true,
+		},
+		{
+			name:     "insecure http endpoint url",
+			config:   &Config{OtlpEndpointKind: "http", OtlpEndpointURL: "http://collector:4318"},
+			expected: true,
+		},
+		{
+			name:     "secure https endpoint url",
+			config:   &Config{OtlpEndpointKind: "http", OtlpEndpointURL: "https://collector:4318"},
+			expected: false,
+		},
+		{
+			name:     "insecure grpc endpoint url",
+			config:   &Config{OtlpEndpointKind: "grpc", OtlpEndpointURL: "grpc://collector:4317"},
+			expected: true,
+		},
+		{
+			name:     "secure grpcs endpoint url",
+			config:   &Config{OtlpEndpointKind: "grpc", OtlpEndpointURL: "grpcs://collector:4317"},
+			expected: false,
+		},
+		{
+			name: "insecure enabled for secure endpoint url",
+			config: &Config{
+				OtlpEndpointKind: "http", OtlpEndpointURL: "https://collector:4318", OtlpInsecure: true,
+			},
+			wantErr: true,
+		},
+		{
+			name:    "tls enabled for insecure endpoint",
+			config:  &Config{OtlpEndpointKind: "http", OtlpHost: "collector", OtlpInsecure: true, OtlpTLSEnabled: true},
+			wantErr: true,
+		},
+		{
+			name:    "endpoint url scheme unsupported by endpoint kind",
+			config:  &Config{OtlpEndpointKind: "http", OtlpEndpointURL: "grpc://collector:4317"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			insecure, err := otlpInsecure(tt.config)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("otlpInsecure() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if insecure != tt.expected {
+				t.Errorf("otlpInsecure() = %v, expected %v", insecure, tt.expected)
+			}
+		})
+	}
+}
+
+func TestOtlpEnvEndpointURL(t *testing.T) {
+	tests := []struct {
+		name           string
+		tracesEndpoint string
+		endpoint       string
+		urlPath        string
+		expected       string
+	}{
+		{
+			name:     "unset",
+			urlPath:  "/v1/traces",
+			expected: "",
+		},
+		{
+			name:           "traces endpoint used as-is",
+			tracesEndpoint: "http://collector:4318/custom",
+			endpoint:       "http://other:4318",
+			urlPath:        "/v1/traces",
+			expected:       "http://collector:4318/custom",
+		},
+		{
+			name:     "endpoint joined with url path",
+			endpoint: "http://collector:4318",
+			urlPath:  "/v1/traces",
+			expected: "http://collector:4318/v1/traces",
+		},
+		{
+			name:     "endpoint with base path joined with url path",
+			endpoint: "http://collector:4318/otlp/",
+			urlPath:  "/v1/traces",
+			expected: "http://collector:4318/otlp/v1/traces",
+		},
+		{
+			name:     "endpoint without url path",
+			endpoint: "grpc://collector:4317",
+			expected: "grpc://collector:4317",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT", tt.tracesEndpoint)
+			t.Setenv("OTEL_EXPORTER_OTLP_ENDPOINT", tt.endpoint)
+
+			if endpointURL := otlpEnvEndpointURL(tt.urlPath); endpointURL != tt.expected {
+				t.Errorf("otlpEnvEndpointURL() = '%s', expected '%s'", endpointURL, tt.expected)
+			}
+		})
+	}
+}
+
+func TestOtlpEnvConfig(t *testing.T) {
+	t.Setenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT", "")
+	t.Setenv("OTEL_EXPORTER_OTLP_ENDPOINT", "http://collector:4318")
+
+	tests := []struct {
+		name     string
+		config   *Config
+		expected string
+	}{
+		{
+			name:     "env endpoint used without host or endpoint url",
+			config:   &Config{OtlpEndpointKind: "http"},
+			expected: "http://collector:4318/v1/traces",
+		},
+		{
+			name:     "host takes precedence",
+			config:   &Config{OtlpEndpointKind: "http", OtlpHost: "localhost"},
+			expected: "",
+		},
+		{
+			name:     "endpoint url takes precedence",
+			config:   &Config{OtlpEndpointKind: "http", OtlpEndpointURL: "https://configured:4318"},
+			expected: "https://configured:4318",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if endpointURL := otlpEnvConfig(tt.config).OtlpEndpointURL; endpointURL != tt.expected {
+				t.Errorf("otlpEnvConfig() endpoint url = '%s', expected '%s'", endpointURL, tt.expected)
+			}
+		})
+	}
+}
+
+func TestOtlpPort(t *testing.T) {
+	tests := []struct {
+		name         string
+		endpointKind string
+		port         int
+		expected     int
+	}{
+		{name: "http default", endpointKind: "http", expected: 4318},
+		{name: "grpc default", endpointKind: "grpc", expected: 4317},
+		{name: "unset endpoint kind default", endpointKind: "", expected: 4318},
+		{name: "configured http port", endpointKind: "http", port: 8080, expected: 8080},
+		{name: "configured grpc port", endpointKind: "grpc", port: 9090, expected: 9090},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if port := otlpPort(tt.endpointKind, tt.port); port != tt.expected {
+				t.Errorf("otlpPort() = %d, expected %d", port, tt.expected)
+			}
+		})
+	}
+}
+
+func TestOtlpRetryIntervals(t *testing.T) {
+	initialInterval, maxInterval, maxElapsedTime := otlpRetryIntervals(&Config{})
+	if initialInterval != defaultOtlpRetryInitialInterval || maxInterval != defaultOtlpRetryMaxInterval ||
+		maxElapsedTime != defaultOtlpRetryMaxElapsedTime {
+		t.Errorf("otlpRetryIntervals() = %v, %v, %v, expected defaults", initialInterval, maxInterval, maxElapsedTime)
+	}
+
+	initialInterval, maxInterval, maxElapsedTime = otlpRetryIntervals(&Config{
+		OtlpRetryInitialInterval: time.Second,
+		OtlpRetryMaxInterval:     2 * time.Second,
+		OtlpRetryMaxElapsedTime:  3 * time.Second,
+	})
+	if initialInterval != time.Second || maxInterval != 2*time.Second || maxElapsedTime != 3*time.Second {
+		t.Errorf("otlpRetryIntervals() = %v, %v, %v, expected configured values", initialInterval, maxInterval,
+			maxElapsedTime)
+	}
+}
+
+func TestNewOtlpExportSettings(t *testing.T) {
+	tests := []struct {
+		name        string
+		config      *Config
+		endpoint    string
+		endpointURL string
+		gzip        bool
+		retry       bool
+		wantErr     bool
+	}{
+		{
+			name:        "http host defaults",
+			config:      &Config{OtlpEndpointKind: "http", OtlpHost: "collector"},
+			endpoint:    "collector:4318",
+			endpointURL: "https://collector:4318/v1/metrics",
+			gzip:        true,
+			retry:       true,
+		},
+		{
+			name: "grpc endpoint url without compression or retry",
+			config: &Config{
+				OtlpEndpointKind:  "grpc",
+				OtlpEndpointURL:   "grpc://collector:4317",
+				OtlpCompression:   "none",
+				OtlpRetryDisabled: true,
+			},
+			endpoint:    "collector:4317",
+			endpointURL: "grpc://collector:4317",
+		},
+		{
+			name:    "missing host and endpoint url",
+			config:  &Config{OtlpEndpointKind: "http"},
+			wantErr: true,
+		},
+		{
+			name:    "unsupported endpoint kind",
+			config:  &Config{OtlpEndpointKind: "thrift", OtlpHost: "collector"},
+			wantErr: true,
+		},
+		{
+			name:    "unsupported compression",
+			config:  &Config{OtlpEndpointKind: "http", OtlpHost: "collector", OtlpCompression: "zstd"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			settings, err := newOtlpExportSettings(tt.config, "/v1/metrics")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("newOtlpExportSettings() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr {
+				return
+			}
+
+			if settings.endpoint != tt.endpoint {
+				t.Errorf("endpoint = '%s', expected '%s'", settings.endpoint, tt.endpoint)
+			}
+
+			if settings.endpointURL != tt.endpointURL {
+				t.Errorf("endpoint url = '%s', expected '%s'", settings.endpointURL, tt.endpointURL)
+			}
+
+			if settings.gzip != tt.gzip {
+				t.Errorf("gzip = %v, expected %v", settings.gzip, tt.gzip)
+			}
+
+			if settings.retryEnabled != tt.retry {
+				t.Errorf("retry enabled = %v, expected %v", settings.retryEnabled, tt.retry)
+			}
+		})
+	}
+}
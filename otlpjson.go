@@ -0,0 +1,198 @@
+package bobotel
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// NOTE: matches the default timeout of the open-telemetry otlp exporters
+const defaultOtlpJSONTimeout = 10 * time.Second
+
+// otlpJSONIDKeys defines the OTLP/JSON keys of trace and span ID values, which are hex encoded rather than base64
+// encoded as protojson encodes bytes.
+var otlpJSONIDKeys = map[string]bool{"traceId": true, "spanId": true, "parentSpanId": true}
+
+// newOtlpJSONClient creates an otlp client that sends OTLP/JSON encoded export requests over http, which the
+// open-telemetry otlp http exporter does not support. Export requests are not retried.
+func newOtlpJSONClient(c *Config, insecure bool) (otlptrace.Client, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	if c.OtlpTLSEnabled {
+		tlsConfig, err := newTLSConfig(c)
+		if err != nil {
+			return nil, fmt.Errorf("problem creating otlp tls configuration: %w", err)
+		}
+
+		transport.TLSClientConfig = tlsConfig
+	}
+
+	timeout := c.OtlpTimeout
+	if timeout <= 0 {
+		timeout = defaultOtlpJSONTimeout
+	}
+
+	switch c.OtlpCompression {
+	case "", "gzip", "none":
+	default:
+		return nil, fmt.Errorf("unsupported otlp compression: %s", c.OtlpCompression)
+	}
+
+	endpointURL := otlpHTTPEndpointURL(c, insecure, "/v1/traces")
+
+	if c.OtlpHTTPPath != "" {
+		parsedURL, err := parseOtlpEndpointURL(endpointURL)
+		if err != nil {
+			return nil, err
+		}
+
+		parsedURL.Path = c.OtlpHTTPPath
+		endpointURL = parsedURL.String()
+	}
+
+	client := &otlpJSONClient{
+		endpointURL: endpointURL,
+		headers:     c.OtlpHeaders,
+		userAgent:   otlpUserAgent(c),
+		gzip:        c.OtlpCompression != "none",
+		httpClient:  &http.Client{Transport: transport, Timeout: timeout},
+	}
+
+	return client, nil
+}
+
+// otlpJSONClient is an otlptrace.Client that sends OTLP/JSON encoded export requests over http.
+type otlpJSONClient struct {
+	endpointURL string
+	headers     map[string]string
+	userAgent   string
+	gzip        bool
+	httpClient  *http.Client
+}
+
+func (c *otlpJSONClient) Start(context.Context) error {
+	return nil
+}
+
+func (c *otlpJSONClient) Stop(context.Context) error {
+	c.httpClient.CloseIdleConnections()
+
+	return nil
+}
+
+func (c *otlpJSONClient) UploadTraces(ctx context.Context, protoSpans []*tracepb.ResourceSpans) error {
+	body, err := marshalOtlpJSON(&coltracepb.ExportTraceServiceRequest{ResourceSpans: protoSpans})
+	if err != nil {
+		return err
+	}
+
+	if c.gzip {
+		buffer := bytes.Buffer{}
+		writer := gzip.NewWriter(&buffer)
+
+		if _, err = writer.Write(body); err == nil {
+			err = writer.Close()
+		}
+
+		if err != nil {
+			return fmt.Errorf("problem compressing otlp json export request: %w", err)
+		}
+
+		body = buffer.Bytes()
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpointURL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("problem creating otlp json export request: %w", err)
+	}
+
+	request.Header.Set("User-Agent", c.userAgent)
+
+	for key, value := range c.headers {
+		request.Header.Set(key, value)
+	}
+
+	request.Header.Set("Content-Type", "application/json")
+
+	if c.gzip {
+		request.Header.Set("Content-Encoding", "gzip")
+	}
+
+	response, err := c.httpClient.Do(request)
+	if err != nil {
+		return fmt.Errorf("problem sending otlp json export request: %w", err)
+	}
+
+	defer response.Body.Close()
+
+	// NOTE: the response body is drained so that the connection can be reused
+	_, _ = io.Copy(io.Discard, response.Body)
+
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return fmt.Errorf("otlp json export request failed with status: %s", response.Status)
+	}
+
+	return nil
+}
+
+// marshalOtlpJSON encodes the given export request as OTLP/JSON, which encodes enums as integers and trace and span IDs
+// as hex strings.
+func marshalOtlpJSON(request *coltracepb.ExportTraceServiceRequest) ([]byte, error) {
+	protoJSON, err := protojson.MarshalOptions{UseEnumNumbers: true}.Marshal(request)
+	if err != nil {
+		return nil, fmt.Errorf("problem encoding otlp json export request: %w", err)
+	}
+
+	var value any
+	if err = json.Unmarshal(protoJSON, &value); err != nil {
+		return nil, fmt.Errorf("problem encoding otlp json export request: %w", err)
+	}
+
+	if err = hexEncodeOtlpJSONIDs(value); err != nil {
+		return nil, fmt.Errorf("problem encoding otlp json export request: %w", err)
+	}
+
+	return json.Marshal(value)
+}
+
+func hexEncodeOtlpJSONIDs(value any) error {
+	switch typedValue := value.(type) {
+	case map[string]any:
+		for key, fieldValue := range typedValue {
+			if id, ok := fieldValue.(string); ok && otlpJSONIDKeys[key] {
+				decodedID, err := base64.StdEncoding.DecodeString(id)
+				if err != nil {
+					return fmt.Errorf("problem decoding '%s' value: %w", key, err)
+				}
+
+				typedValue[key] = hex.EncodeToString(decodedID)
+
+				continue
+			}
+
+			if err := hexEncodeOtlpJSONIDs(fieldValue); err != nil {
+				return err
+			}
+		}
+	case []any:
+		for _, item := range typedValue {
+			if err := hexEncodeOtlpJSONIDs(item); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,134 @@
+package bobotel
+
+import (
+	"encoding/json"
+	"testing"
+
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+)
+
+func TestMarshalOtlpJSON(t *testing.T) {
+	tests := []struct {
+		name                 string
+		span                 *tracepb.Span
+		expectedTraceID      string
+		expectedSpanID       string
+		expectedParentSpanID string
+	}{
+		{
+			name: "trace and span ids",
+			span: &tracepb.Span{
+				TraceId: []byte{
+					0x5b, 0x8e, 0xff, 0xf7, 0x98, 0x03, 0x81, 0x03, 0xd2, 0x69, 0xb6, 0x33, 0x81, 0x3f, 0xc6, 0x0c,
+				},
+				SpanId: []byte{0xee, 0xe1, 0x9b, 0x7e, 0xc3, 0xc1, 0xb1, 0x74},
+			},
+			expectedTraceID: "5b8efff798038103d269b633813fc60c",
+			expectedSpanID:  "eee19b7ec3c1b174",
+		},
+		{
+			name: "parent span id",
+			span: &tracepb.Span{
+				TraceId:      []byte{0x01, 15: 0x02},
+				SpanId:       []byte{0x03, 7: 0x04},
+				ParentSpanId: []byte{0x05, 7: 0x06},
+			},
+			expectedTraceID:      "01000000000000000000000000000002",
+			expectedSpanID:       "0300000000000004",
+			expectedParentSpanID: "0500000000000006",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			request := &coltracepb.ExportTraceServiceRequest{
+				ResourceSpans: []*tracepb.ResourceSpans{
+					{ScopeSpans: []*tracepb.ScopeSpans{{Spans: []*tracepb.Span{tt.span}}}},
+				},
+			}
+
+			encoded, err := marshalOtlpJSON(request)
+			if err != nil {
+				t.Fatalf("marshalOtlpJSON() error = %v", err)
+			}
+
+			var decoded struct {
+				ResourceSpans []struct {
+					ScopeSpans []struct {
+						Spans []struct {
+							TraceID      string `json:"traceId"`
+							SpanID       string `json:"spanId"`
+							ParentSpanID string `json:"parentSpanId"`
+						} `json:"spans"`
+					} `json:"scopeSpans"`
+				} `json:"resourceSpans"`
+			}
+
+			if err = json.Unmarshal(encoded, &decoded); err != nil {
+				t.Fatalf("Unmarshal() error = %v", err)
+			}
+
+			span := decoded.ResourceSpans[0].ScopeSpans[0].Spans[0]
+
+			if span.TraceID != tt.expectedTraceID {
+				t.Errorf("traceId = '%s', expected '%s'", span.TraceID, tt.expectedTraceID)
+			}
+
+			if span.SpanID != tt.expectedSpanID {
+				t.Errorf("spanId = '%s', expected '%s'", span.SpanID, tt.expectedSpanID)
+			}
+
+			if span.ParentSpanID != tt.expectedParentSpanID {
+				t.Errorf("parentSpanId = '%s', expected '%s'", span.ParentSpanID, tt.expectedParentSpanID)
+			}
+		})
+	}
+}
+
+func TestHexEncodeOtlpJSONIDs(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    any
+		expected string
+		wantErr  bool
+	}{
+		{
+			name:     "nested ids",
+			value:    map[string]any{"links": []any{map[string]any{"traceId": "AQI=", "spanId": "AwQ="}}},
+			expected: `{"links":[{"spanId":"0304","traceId":"0102"}]}`,
+		},
+		{
+			name:     "non-id keys unchanged",
+			value:    map[string]any{"name": "AQI=", "kind": 1.0},
+			expected: `{"kind":1,"name":"AQI="}`,
+		},
+		{
+			name:    "invalid base64 id",
+			value:   map[string]any{"traceId": "not base64!"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := hexEncodeOtlpJSONIDs(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("hexEncodeOtlpJSONIDs() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr {
+				return
+			}
+
+			encoded, err := json.Marshal(tt.value)
+			if err != nil {
+				t.Fatalf("Marshal() error = %v", err)
+			}
+
+			if string(encoded) != tt.expected {
+				t.Errorf("hexEncodeOtlpJSONIDs() = %s, expected %s", encoded, tt.expected)
+			}
+		})
+	}
+}
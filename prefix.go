@@ -0,0 +1,156 @@
+package bobotel
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/xavi-group/bconf"
+)
+
+// PrefixedFieldSets defines the field-sets for an open-telemetry tracer mounted under the given prefix, which avoids
+// key collisions when bobotel configuration is embedded alongside other subsystems. The prefix is joined to each
+// field-set key with an underscore, as bconf field-set keys cannot contain '.' (e.g. the prefix 'telemetry' mounts the
+// 'otel' field-set as 'telemetry_otel', loaded from environment keys such as 'TELEMETRY_OTEL_EXPORTERS'). The
+// unprefixed field-sets are returned when the prefix is empty.
+//
+// The Config struct tags reference the unprefixed field-sets, so a Config is filled from prefixed field-sets via
+// FillPrefixedConfig after loading rather than via bconf.AppConfig.AttachConfigStructs.
+func PrefixedFieldSets(prefix string) bconf.FieldSets {
+	fieldSets := FieldSets()
+
+	if prefix == "" {
+		return fieldSets
+	}
+
+	for _, fieldSet := range fieldSets {
+		fieldSet.Key = prefixedFieldSetKey(prefix, fieldSet.Key)
+		fieldSet.LoadConditions = prefixedLoadConditions(prefix, fieldSet.LoadConditions)
+
+		for _, field := range fieldSet.Fields {
+			field.LoadConditions = prefixedLoadConditions(prefix, field.LoadConditions)
+		}
+	}
+
+	return fieldSets
+}
+
+// FillPrefixedConfig fills the given Config from the field-sets defined by PrefixedFieldSets with the given prefix,
+// after the app config has been loaded. Fields without loaded values keep their existing values.
+func FillPrefixedConfig(appConfig *bconf.AppConfig, prefix string, c *Config) error {
+	if appConfig == nil || c == nil {
+		return fmt.Errorf("app config and config required to fill prefixed config")
+	}
+
+	configValue := reflect.ValueOf(c).Elem()
+	configType := configValue.Type()
+
+	structFields := []reflect.StructField{}
+	fieldIndexes := []int{}
+
+	for idx := range configType.NumField() {
+		field := configType.Field(idx)
+
+		fieldSetKey, fieldKey, found := strings.Cut(field.Tag.Get("bconf"), ".")
+		if !found {
+			continue
+		}
+
+		if isBobotelFieldSetKey(fieldSetKey) {
+			fieldSetKey = prefixedFieldSetKey(prefix, fieldSetKey)
+		}
+
+		structFields = append(structFields, reflect.StructField{
+			Name: field.Name,
+			Type: field.Type,
+			Tag:  reflect.StructTag(fmt.Sprintf(`bconf:"%s.%s"`, fieldSetKey, fieldKey)),
+		})
+		fieldIndexes = append(fieldIndexes, idx)
+	}
+
+	// NOTE: the prefixed struct is seeded with the existing values, as fields without loaded values are not filled
+	prefixedConfig := reflect.New(reflect.StructOf(structFields))
+
+	for idx, fieldIndex := range fieldIndexes {
+		prefixedConfig.Elem().Field(idx).Set(configValue.Field(fieldIndex))
+	}
+
+	if err := appConfig.FillStruct(prefixedConfig.Interface()); err != nil {
+		return fmt.Errorf("problem filling prefixed config: %w", err)
+	}
+
+	for idx, fieldIndex := range fieldIndexes {
+		configValue.Field(fieldIndex).Set(prefixedConfig.Elem().Field(idx))
+	}
+
+	return nil
+}
+
+func prefixedFieldSetKey(prefix, fieldSetKey string) string {
+	if prefix == "" {
+		return fieldSetKey
+	}
+
+	return fmt.Sprintf("%s_%s", strings.ReplaceAll(prefix, ".", "_"), fieldSetKey)
+}
+
+func isBobotelFieldSetKey(fieldSetKey string) bool {
+	switch fieldSetKey {
+	case OtelFieldSetKey, OtlpFieldSetKey, SpanLimitsFieldSetKey, ZipkinFieldSetKey:
+		return true
+	default:
+		return false
+	}
+}
+
+func prefixedLoadConditions(prefix string, loadConditions bconf.LoadConditions) bconf.LoadConditions {
+	prefixed := make(bconf.LoadConditions, 0, len(loadConditions))
+
+	for _, loadCondition := range loadConditions {
+		prefixed = append(prefixed, &prefixedLoadCondition{LoadCondition: loadCondition, prefix: prefix})
+	}
+
+	return prefixed
+}
+
+// prefixedLoadCondition wraps a load condition built against the unprefixed field-sets, where field dependencies are
+// reported with prefixed field-set keys, and dependency values are passed to the wrapped load condition unprefixed.
+type prefixedLoadCondition struct {
+	bconf.LoadCondition
+	prefix string
+}
+
+func (c *prefixedLoadCondition) Clone() bconf.LoadCondition {
+	return &prefixedLoadCondition{LoadCondition: c.LoadCondition.Clone(), prefix: c.prefix}
+}
+
+func (c *prefixedLoadCondition) FieldDependencies() bconf.FieldLocations {
+	dependencies := c.LoadCondition.FieldDependencies()
+
+	for idx, dependency := range dependencies {
+		if isBobotelFieldSetKey(dependency.FieldSetKey) {
+			dependencies[idx].FieldSetKey = prefixedFieldSetKey(c.prefix, dependency.FieldSetKey)
+		}
+	}
+
+	return dependencies
+}
+
+func (c *prefixedLoadCondition) SetFieldValues(fieldValues ...bconf.FieldValue) {
+	unprefixedValues := make([]bconf.FieldValue, 0, len(fieldValues))
+
+	for _, fieldValue := range fieldValues {
+		fieldSetKey, found := strings.CutPrefix(fieldValue.FieldSetKey, prefixedFieldSetKey(c.prefix, ""))
+		if found && isBobotelFieldSetKey(fieldSetKey) {
+			fieldValue.FieldSetKey = fieldSetKey
+		}
+
+		unprefixedValues = append(unprefixedValues, fieldValue)
+	}
+
+	c.LoadCondition.SetFieldValues(unprefixedValues...)
+}
+
+func (c *prefixedLoadCondition) Load(bconf.FieldValueFinder) (bool, error) {
+	return c.LoadCondition.Load(c.LoadCondition)
+}
@@ -0,0 +1,111 @@
+package bobotel
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/xavi-group/bconf"
+)
+
+func TestPrefixedFieldSetKey(t *testing.T) {
+	tests := []struct {
+		name        string
+		prefix      string
+		fieldSetKey string
+		expected    string
+	}{
+		{name: "empty prefix", prefix: "", fieldSetKey: OtelFieldSetKey, expected: "otel"},
+		{name: "prefix", prefix: "telemetry", fieldSetKey: OtlpFieldSetKey, expected: "telemetry_otlp"},
+		{name: "dotted prefix", prefix: "app.telemetry", fieldSetKey: OtelFieldSetKey, expected: "app_telemetry_otel"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if key := prefixedFieldSetKey(tt.prefix, tt.fieldSetKey); key != tt.expected {
+				t.Errorf("prefixedFieldSetKey() = '%s', expected '%s'", key, tt.expected)
+			}
+		})
+	}
+}
+
+func TestPrefixedFieldSets(t *testing.T) {
+	fieldSets := PrefixedFieldSets("telemetry")
+
+	for _, fieldSet := range fieldSets {
+		if !slices.Contains(
+			[]string{"telemetry_otel", "telemetry_otlp", "telemetry_span_limits", "telemetry_zipkin"}, fieldSet.Key,
+		) {
+			t.Errorf("PrefixedFieldSets() field-set key = '%s', expected a 'telemetry_' prefixed key", fieldSet.Key)
+		}
+	}
+
+	unprefixed := PrefixedFieldSets("")
+	if len(unprefixed) != len(FieldSets()) || unprefixed[0].Key != OtelFieldSetKey {
+		t.Errorf("PrefixedFieldSets() with an empty prefix did not return the unprefixed field-sets")
+	}
+}
+
+func TestFillPrefixedConfig(t *testing.T) {
+	tests := []struct {
+		name      string
+		env       map[string]string
+		exporters []string
+		host      string
+		wantErr   bool
+	}{
+		{
+			name:      "otlp field-set loaded for otlp exporter",
+			env:       map[string]string{"TELEMETRY_OTEL_EXPORTERS": "otlp", "TELEMETRY_OTLP_HOST": "collector"},
+			exporters: []string{"otlp"},
+			host:      "collector",
+		},
+		{
+			name:      "otlp field-set not loaded without otlp exporter",
+			env:       map[string]string{"TELEMETRY_OTEL_EXPORTERS": "console", "TELEMETRY_OTLP_HOST": "collector"},
+			exporters: []string{"console"},
+			host:      "",
+		},
+		{
+			name:    "unsupported exporter",
+			env:     map[string]string{"TELEMETRY_OTEL_EXPORTERS": "unsupported"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for key, value := range tt.env {
+				t.Setenv(key, value)
+			}
+
+			appConfig := bconf.NewAppConfig("test", "test app", bconf.WithEnvironmentLoader())
+			appConfig.AddFieldSetGroup("bobotel", PrefixedFieldSets("telemetry"))
+
+			errs := appConfig.Load(bconf.DisableHelpFlagHandler(), bconf.DisableGenerateFlagHandler())
+			if len(errs) > 0 {
+				if !tt.wantErr {
+					t.Fatalf("Load() errors = %v", errs)
+				}
+
+				return
+			}
+
+			if tt.wantErr {
+				t.Fatal("Load() errors = [], expected an error")
+			}
+
+			config := NewConfig()
+			if err := FillPrefixedConfig(appConfig, "telemetry", config); err != nil {
+				t.Fatalf("FillPrefixedConfig() error = %v", err)
+			}
+
+			if !slices.Equal(config.OtelExporters, tt.exporters) {
+				t.Errorf("exporters = %v, expected %v", config.OtelExporters, tt.exporters)
+			}
+
+			if config.OtlpHost != tt.host {
+				t.Errorf("otlp host = '%s', expected '%s'", config.OtlpHost, tt.host)
+			}
+		})
+	}
+}
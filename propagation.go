@@ -0,0 +1,130 @@
+package bobotel
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"go.opentelemetry.io/contrib/propagators/aws/xray"
+	"go.opentelemetry.io/contrib/propagators/b3"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+)
+
+// MapCarrier is a propagation.TextMapCarrier for map[string]string headers, such as message queue headers.
+type MapCarrier = propagation.MapCarrier
+
+// baggageHeader defines the W3C baggage header extracted by the baggage propagator.
+const baggageHeader = "baggage"
+
+// defaultPropagators defines the propagators used when none are configured.
+var defaultPropagators = []string{"tracecontext", "baggage"}
+
+func newPropagator(c *Config) (propagation.TextMapPropagator, error) {
+	propagatorNames := c.OtelPropagators
+	if len(propagatorNames) < 1 {
+		propagatorNames = defaultPropagators
+	}
+
+	propagators := make([]propagation.TextMapPropagator, 0, len(propagatorNames))
+
+	for _, propagatorName := range propagatorNames {
+		switch propagatorName {
+		case "tracecontext":
+			propagators = append(propagators, propagation.TraceContext{})
+		case "baggage":
+			propagators = append(propagators, newBaggagePropagator(c.OtelMaxBaggageBytes))
+		case "b3", "b3-multi":
+			propagators = append(propagators, b3.New(b3.WithInjectEncoding(b3.B3MultipleHeader)))
+		case "b3-single":
+			propagators = append(propagators, b3.New(b3.WithInjectEncoding(b3.B3SingleHeader)))
+		case "xray":
+			propagators = append(propagators, xray.Propagator{})
+		default:
+			return nil, fmt.Errorf("unsupported propagator found: %s", propagatorName)
+		}
+	}
+
+	return propagation.NewCompositeTextMapPropagator(propagators...), nil
+}
+
+// newBaggagePropagator returns the W3C baggage propagator, wrapped with a propagator that limits the size of extracted
+// baggage when a max baggage bytes limit is configured.
+func newBaggagePropagator(maxBytes int) propagation.TextMapPropagator {
+	if maxBytes <= 0 {
+		return propagation.Baggage{}
+	}
+
+	return &limitedBaggagePropagator{Baggage: propagation.Baggage{}, maxBytes: maxBytes}
+}
+
+// limitedBaggagePropagator wraps the W3C baggage propagator, and truncates incoming baggage exceeding the max bytes
+// limit to its leading members before extraction. Injected baggage is not limited.
+type limitedBaggagePropagator struct {
+	propagation.Baggage
+	maxBytes int
+}
+
+func (p *limitedBaggagePropagator) Extract(parent context.Context, carrier propagation.TextMapCarrier) context.Context {
+	var values []string
+
+	// NOTE: multiple baggage headers are combined, matching the extraction of the W3C baggage propagator
+	if valuesGetter, ok := carrier.(propagation.ValuesGetter); ok {
+		values = valuesGetter.Values(baggageHeader)
+	} else if value := carrier.Get(baggageHeader); value != "" {
+		values = []string{value}
+	}
+
+	if len(values) < 1 {
+		return parent
+	}
+
+	value := truncateBaggage(strings.Join(values, ","), p.maxBytes)
+
+	return p.Baggage.Extract(parent, propagation.MapCarrier{baggageHeader: value})
+}
+
+// truncateBaggage returns the leading list-members of the given baggage header value that fit within max bytes, where
+// list-members are never split.
+func truncateBaggage(value string, maxBytes int) string {
+	if len(value) <= maxBytes {
+		return value
+	}
+
+	members := []string{}
+	size := 0
+
+	for member := range strings.SplitSeq(value, ",") {
+		member = strings.TrimSpace(member)
+		if member == "" {
+			continue
+		}
+
+		memberSize := len(member)
+		if len(members) > 0 {
+			memberSize++
+		}
+
+		if size+memberSize > maxBytes {
+			break
+		}
+
+		members = append(members, member)
+		size += memberSize
+	}
+
+	return strings.Join(members, ",")
+}
+
+// InjectContext injects the trace context and baggage of the given context into the given carrier via the
+// propagators configured by InitializeTraceProvider, e.g. for propagating traces over message queue headers.
+func InjectContext(ctx context.Context, carrier propagation.TextMapCarrier) {
+	otel.GetTextMapPropagator().Inject(ctx, carrier)
+}
+
+// ExtractContext returns a copy of the given context with the trace context and baggage extracted from the given
+// carrier via the propagators configured by InitializeTraceProvider. Spans started from the returned context are
+// children of the remote span that injected the carrier.
+func ExtractContext(ctx context.Context, carrier propagation.TextMapCarrier) context.Context {
+	return otel.GetTextMapPropagator().Extract(ctx, carrier)
+}
@@ -0,0 +1,54 @@
+package bobotel
+
+import (
+	"context"
+	"testing"
+
+	"go.opentelemetry.io/otel/baggage"
+	"go.opentelemetry.io/otel/propagation"
+)
+
+func TestTruncateBaggage(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		maxBytes int
+		expected string
+	}{
+		{name: "within max bytes", value: "a=1,b=2", maxBytes: 7, expected: "a=1,b=2"},
+		{name: "leading members kept", value: "a=1,b=2,c=3", maxBytes: 8, expected: "a=1,b=2"},
+		{name: "members never split", value: "a=1,long=value", maxBytes: 10, expected: "a=1"},
+		{name: "first member exceeds max bytes", value: "long=value,a=1", maxBytes: 5, expected: ""},
+		{name: "whitespace and empty members dropped", value: "a=1 , ,b=2,c=3", maxBytes: 9, expected: "a=1,b=2"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if truncated := truncateBaggage(tt.value, tt.maxBytes); truncated != tt.expected {
+				t.Errorf("truncateBaggage() = '%s', expected '%s'", truncated, tt.expected)
+			}
+		})
+	}
+}
+
+func TestNewBaggagePropagator(t *testing.T) {
+	tests := []struct {
+		name     string
+		maxBytes int
+		expected int
+	}{
+		{name: "unlimited", maxBytes: 0, expected: 3},
+		{name: "limited", maxBytes: 8, expected: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			carrier := propagation.MapCarrier{baggageHeader: "a=1,b=2,c=3"}
+			ctx := newBaggagePropagator(tt.maxBytes).Extract(context.Background(), carrier)
+
+			if members := baggage.FromContext(ctx).Len(); members != tt.expected {
+				t.Errorf("extracted %d baggage members, expected %d", members, tt.expected)
+			}
+		})
+	}
+}
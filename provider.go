@@ -0,0 +1,405 @@
+package bobotel
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"sync/atomic"
+
+	"go.opentelemetry.io/contrib/propagators/aws/xray"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+// Provider is an independently configured open-telemetry trace provider. Unlike InitializeTraceProvider, creating a
+// Provider does not modify any package level or global open-telemetry state, which allows multiple providers to be
+// used within a single process.
+type Provider struct {
+	tracerProvider trace.TracerProvider
+	// NOTE: sdkProvider is nil for a no-op provider, so that lifecycle operations never operate on a no-op provider
+	sdkProvider    *sdktrace.TracerProvider
+	propagator     propagation.TextMapPropagator
+	resource       *resource.Resource
+	samplingRatio  *ratioSampler
+	samplingHint   attribute.Key
+	exporters      []string
+	memoryExporter *InMemoryExporter
+	leakedSpans    *leakedSpanProcessor
+	otlpEndpoints  []*Config
+	closers        []io.Closer
+	flusher        *periodicFlusher
+	stats          *spanStats
+	shutdown       atomic.Bool
+}
+
+// NewProvider creates a Provider configured via the given Config and options. A Provider backed by a no-op trace
+// provider is returned when no exporters or span processors are configured.
+func NewProvider(c *Config, opts ...Option) (*Provider, error) {
+	return NewProviderWithContext(context.Background(), c, opts...)
+}
+
+// NewProviderWithContext creates a Provider configured via the given Config and options. The given context is used
+// while creating exporters, and can be used to bound or cancel exporter connection setup.
+func NewProviderWithContext(ctx context.Context, c *Config, opts ...Option) (*Provider, error) {
+	options := newProviderOptions(append([]Option{c}, opts...))
+
+	return newProvider(ctx, options.config, options)
+}
+
+func newProvider(ctx context.Context, c *Config, options providerOptions) (p *Provider, err error) {
+	if c == nil {
+		return nil, errors.New("no trace provider configuration provided")
+	}
+
+	if err := c.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid trace provider configuration: %w", err)
+	}
+
+	reportUnusedConsoleFields(c)
+
+	providerResource, err := newProviderResource(ctx, resourceConfig{
+		appName:            c.AppName,
+		appID:              c.AppID,
+		serviceVersion:     c.ServiceVersion,
+		serviceNamespace:   c.OtelServiceNamespace,
+		resourceAttributes: c.ResourceAttributes,
+		resourceDetectors:  c.OtelResourceDetectors,
+		detectors:          c.ResourceDetectors,
+		minimal:            c.OtelMinimalResource,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("problem creating tracer provider resources: %w", err)
+	}
+
+	if options.resource != nil {
+		providerResource, err = mergeResources(providerResource, options.resource)
+		if err != nil {
+			return nil, fmt.Errorf("problem creating tracer provider resources: %w", err)
+		}
+	}
+
+	sampler, samplingRatio, err := newSampler(c)
+	if err != nil {
+		return nil, fmt.Errorf("problem creating tracer provider sampler: %w", err)
+	}
+
+	if options.sampler != nil {
+		sampler, samplingRatio = options.sampler, nil
+	}
+
+	propagator, err := newPropagator(c)
+	if err != nil {
+		return nil, fmt.Errorf("problem creating tracer provider propagators: %w", err)
+	}
+
+	if len(c.OtelExporters) < 1 && len(options.spanProcessors) < 1 {
+		return &Provider{
+			tracerProvider: noop.NewTracerProvider(),
+			propagator:     propagator,
+			resource:       providerResource,
+			samplingHint:   newSamplingHintAttribute(c),
+		}, nil
+	}
+
+	opts := []sdktrace.TracerProviderOption{
+		sdktrace.WithResource(providerResource),
+		sdktrace.WithSampler(sampler),
+		sdktrace.WithSpanLimits(newSpanLimits(c)),
+	}
+
+	var leakedSpans *leakedSpanProcessor
+
+	if c.OtelTrackLeakedSpans {
+		leakedSpans = newLeakedSpanProcessor()
+		opts = append(opts, sdktrace.WithSpanProcessor(leakedSpans))
+	}
+
+	if c.OtelIDGenerator != nil {
+		opts = append(opts, sdktrace.WithIDGenerator(c.OtelIDGenerator))
+	} else if c.OtelIDFormat == "xray" {
+		opts = append(opts, sdktrace.WithIDGenerator(xray.NewIDGenerator()))
+	}
+
+	batchOptions := newBatchSpanProcessorOptions(c)
+
+	var memoryExporter *InMemoryExporter
+	var exporters []sdktrace.SpanExporter
+	var closers []io.Closer
+
+	stats := &spanStats{}
+
+	// NOTE: exporters are shut down with a background context, as the given context may have caused the error
+	defer func() {
+		if err != nil {
+			for _, exporter := range exporters {
+				_ = exporter.Shutdown(context.Background())
+			}
+
+			for _, closer := range closers {
+				_ = closer.Close()
+			}
+		}
+	}()
+
+	for _, exporter := range c.OtelExporters {
+		switch exporter {
+		case "console":
+			consoleExporter, err := newConsoleExporter(c)
+			if err != nil {
+				return nil, fmt.Errorf("problem creating tracer console exporter: %w", err)
+			}
+
+			exporters = append(exporters, consoleExporter)
+
+			// NOTE: spans below the minimum duration are filtered before counting, so they are not counted as exported
+			consoleExporter = newMinDurationExporter(stats.countingExporter(consoleExporter), c.OtelConsoleMinDuration)
+
+			opts = append(opts, newSpanProcessorOption(c, "console", consoleExporter, batchOptions))
+		case "otlp":
+			sampleRatio, err := parseOtlpSampleRatio(c.OtlpSampleRatio)
+			if err != nil {
+				return nil, fmt.Errorf("problem creating tracer otlp exporter: %w", err)
+			}
+
+			otlpExporter, err := newOtlpExporter(ctx, c)
+			if err != nil {
+				return nil, fmt.Errorf("problem creating tracer otlp exporter: %w", err)
+			}
+
+			exporters = append(exporters, otlpExporter)
+
+			otlpExporter, err = newFallbackExporter(c, otlpExporter)
+			if err != nil {
+				return nil, fmt.Errorf("problem creating tracer otlp exporter: %w", err)
+			}
+
+			// NOTE: spans dropped by the otlp sample ratio are not counted as exported
+			otlpExporter = newTraceIDRatioExporter(stats.countingExporter(otlpExporter), sampleRatio)
+			opts = append(opts, newSpanProcessorOption(c, "otlp", otlpExporter, batchOptions))
+
+			for _, endpoint := range c.OtlpEndpoints {
+				endpointExporter, err := newOtlpExporter(ctx, otlpEndpointConfig(c, endpoint))
+				if err != nil {
+					return nil, fmt.Errorf("problem creating tracer otlp exporter for additional endpoint: %w", err)
+				}
+
+				exporters = append(exporters, endpointExporter)
+
+				endpointExporter, err = newFallbackExporter(c, endpointExporter)
+				if err != nil {
+					return nil, fmt.Errorf("problem creating tracer otlp exporter for additional endpoint: %w", err)
+				}
+
+				endpointExporter = newTraceIDRatioExporter(stats.countingExporter(endpointExporter), sampleRatio)
+				opts = append(opts, newSpanProcessorOption(c, "otlp", endpointExporter, batchOptions))
+			}
+		case "file":
+			fileExporter, fileWriter, err := newFileExporter(c)
+			if err != nil {
+				return nil, fmt.Errorf("problem creating tracer file exporter: %w", err)
+			}
+
+			exporters = append(exporters, fileExporter)
+			closers = append(closers, fileWriter)
+			opts = append(opts, newSpanProcessorOption(c, "file", stats.countingExporter(fileExporter), batchOptions))
+		case "zipkin":
+			zipkinExporter, err := newZipkinExporter(c)
+			if err != nil {
+				return nil, fmt.Errorf("problem creating tracer zipkin exporter: %w", err)
+			}
+
+			exporters = append(exporters, zipkinExporter)
+
+			opts = append(opts, newSpanProcessorOption(
+				c, "zipkin", stats.countingExporter(zipkinExporter), batchOptions,
+			))
+		case "memory":
+			memoryExporter = NewInMemoryExporter()
+
+			// NOTE: in-memory spans are always exported synchronously so that tests can assert on them immediately
+			processor := sdktrace.NewSimpleSpanProcessor(stats.countingExporter(memoryExporter))
+
+			opts = append(opts, sdktrace.WithSpanProcessor(newSpanNameProcessor(c, newSlowSpanProcessor(c, processor))))
+		default:
+			factory, found := registeredExporterFactory(exporter)
+			if !found {
+				return nil, fmt.Errorf("unsupported exporter found: %s", exporter)
+			}
+
+			registeredExporter, err := factory(c)
+			if err != nil {
+				return nil, fmt.Errorf("problem creating tracer %s exporter: %w", exporter, err)
+			}
+
+			exporters = append(exporters, registeredExporter)
+
+			opts = append(opts, newSpanProcessorOption(
+				c, exporter, stats.countingExporter(registeredExporter), batchOptions,
+			))
+		}
+	}
+
+	for _, processor := range options.spanProcessors {
+		opts = append(opts, sdktrace.WithSpanProcessor(processor))
+	}
+
+	opts = append(opts, options.tracerProviderOptions...)
+
+	sdkProvider := sdktrace.NewTracerProvider(opts...)
+
+	return &Provider{
+		tracerProvider: sdkProvider,
+		sdkProvider:    sdkProvider,
+		propagator:     propagator,
+		resource:       providerResource,
+		samplingRatio:  samplingRatio,
+		samplingHint:   newSamplingHintAttribute(c),
+		exporters:      slices.Clone(c.OtelExporters),
+		memoryExporter: memoryExporter,
+		leakedSpans:    leakedSpans,
+		otlpEndpoints:  newOtlpEndpointConfigs(c),
+		closers:        closers,
+		flusher:        newPeriodicFlusher(c, sdkProvider),
+		stats:          stats,
+	}, nil
+}
+
+// newFailOpenProvider creates a no-op Provider used in place of a provider that could not be created. The configured
+// propagators are used when valid, so that trace context is still propagated between services.
+func newFailOpenProvider(c *Config) *Provider {
+	propagator, err := newPropagator(c)
+	if err != nil {
+		propagator, _ = newPropagator(&Config{})
+	}
+
+	return &Provider{
+		tracerProvider: noop.NewTracerProvider(),
+		propagator:     propagator,
+		samplingHint:   newSamplingHintAttribute(c),
+		otlpEndpoints:  newOtlpEndpointConfigs(c),
+	}
+}
+
+// Tracer creates an open-telemetry tracer with the given name and options from the provider.
+func (p *Provider) Tracer(tracerName string, options ...trace.TracerOption) trace.Tracer {
+	return p.tracerProvider.Tracer(tracerName, options...)
+}
+
+// TracerProvider returns the underlying open-telemetry trace provider, e.g. for passing to instrumentation libraries.
+func (p *Provider) TracerProvider() trace.TracerProvider {
+	return p.tracerProvider
+}
+
+// Propagator returns the text map propagator configured for the provider.
+func (p *Provider) Propagator() propagation.TextMapPropagator {
+	return p.propagator
+}
+
+// MarkForSampling sets the provider's configured sampling hint attribute on the given span, marking the trace for
+// retention by a collector tail sampler.
+func (p *Provider) MarkForSampling(span trace.Span) {
+	SetAttributes(span, p.samplingHint.Int(1))
+}
+
+// SetSamplingRatio sets the ratio used by the provider's configured traceidratio samplers, which takes effect for
+// sampling decisions made after it returns. An error is returned if the ratio is invalid, or if the provider's
+// configured sampler does not use a sampling ratio.
+func (p *Provider) SetSamplingRatio(ratio float64) error {
+	if err := otelSamplerRatioValidator(ratio); err != nil {
+		return err
+	}
+
+	if p.samplingRatio == nil {
+		return errors.New("configured sampler does not use a sampling ratio")
+	}
+
+	p.samplingRatio.setRatio(ratio)
+
+	return nil
+}
+
+// Exporters returns the names of the exporters installed on the provider. Nil is returned for a provider without
+// exporters.
+func (p *Provider) Exporters() []string {
+	return slices.Clone(p.exporters)
+}
+
+// GetRecordedSpans returns the spans recorded by the provider's in-memory exporter. Nil is returned if the provider
+// was not configured with the 'memory' exporter.
+func (p *Provider) GetRecordedSpans() tracetest.SpanStubs {
+	if p.memoryExporter == nil {
+		return nil
+	}
+
+	return p.memoryExporter.GetRecordedSpans()
+}
+
+// GetInMemoryExporter returns the provider's in-memory exporter. Nil is returned if the provider was not configured
+// with the 'memory' exporter.
+func (p *Provider) GetInMemoryExporter() *InMemoryExporter {
+	return p.memoryExporter
+}
+
+// LeakedSpans returns the names of the spans started but not ended on the provider, which remain available after the
+// provider is shut down. Nil is returned if the provider was not configured with OtelTrackLeakedSpans.
+func (p *Provider) LeakedSpans() []string {
+	if p.leakedSpans == nil {
+		return nil
+	}
+
+	return p.leakedSpans.leakedSpans()
+}
+
+// Stats returns the span stats of the provider. Zero stats are returned for a provider without exporters.
+func (p *Provider) Stats() SpanStats {
+	return p.stats.snapshot()
+}
+
+// ForceFlush exports any pending spans. ForceFlush is a no-op for a provider without exporters.
+func (p *Provider) ForceFlush(ctx context.Context) error {
+	if p.sdkProvider != nil {
+		if err := p.sdkProvider.ForceFlush(ctx); err != nil {
+			return fmt.Errorf("problem flushing trace provider: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// Shutdown flushes any pending spans and shuts down the provider. Shutdown is a no-op for a provider without
+// exporters.
+func (p *Provider) Shutdown(ctx context.Context) error {
+	p.shutdown.Store(true)
+	p.flusher.stop()
+
+	errs := []error{}
+
+	if p.sdkProvider != nil {
+		_ = p.sdkProvider.ForceFlush(ctx)
+
+		if err := p.sdkProvider.Shutdown(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("problem shutting down trace provider: %w", err))
+		}
+	}
+
+	// NOTE: closers are always closed, so that exporter resources (e.g. files) are released if shutdown fails
+	for _, closer := range p.closers {
+		if err := closer.Close(); err != nil {
+			errs = append(errs, fmt.Errorf("problem shutting down trace provider: %w", err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// active returns whether the provider is backed by an open-telemetry SDK trace provider that has not been shut down.
+func (p *Provider) active() bool {
+	return p.sdkProvider != nil && !p.shutdown.Load()
+}
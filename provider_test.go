@@ -0,0 +1,207 @@
+package bobotel
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync/atomic"
+	"testing"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// testExporter is a span exporter that records whether it was shut down.
+type testExporter struct {
+	shutdown atomic.Bool
+}
+
+func (e *testExporter) ExportSpans(context.Context, []sdktrace.ReadOnlySpan) error {
+	return nil
+}
+
+func (e *testExporter) Shutdown(context.Context) error {
+	e.shutdown.Store(true)
+
+	return nil
+}
+
+// testCloser is an io.Closer that records whether it was closed, and returns the given error.
+type testCloser struct {
+	closed bool
+	err    error
+}
+
+func (c *testCloser) Close() error {
+	c.closed = true
+
+	return c.err
+}
+
+// registerTestExporter registers the given exporter factory, and unregisters it once the test completes.
+func registerTestExporter(t *testing.T, name string, factory ExporterFactory) {
+	t.Helper()
+
+	if err := RegisterExporter(name, factory); err != nil {
+		t.Fatalf("RegisterExporter() error = %v", err)
+	}
+
+	t.Cleanup(func() {
+		exporterFactoriesLock.Lock()
+		defer exporterFactoriesLock.Unlock()
+
+		delete(exporterFactories, name)
+	})
+}
+
+func TestNewProvider(t *testing.T) {
+	tests := []struct {
+		name      string
+		config    *Config
+		exporters []string
+		noop      bool
+		wantErr   bool
+	}{
+		{
+			name:      "memory exporter",
+			config:    &Config{OtelExporters: []string{"memory"}},
+			exporters: []string{"memory"},
+		},
+		{
+			name:   "no exporters",
+			config: &Config{OtelExporters: []string{}},
+			noop:   true,
+		},
+		{
+			name:    "nil config",
+			wantErr: true,
+		},
+		{
+			name:    "unsupported exporter",
+			config:  &Config{OtelExporters: []string{"unsupported"}},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			provider, err := NewProvider(tt.config)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewProvider() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr {
+				return
+			}
+
+			defer provider.Shutdown(context.Background())
+
+			if exporters := provider.Exporters(); len(exporters) != len(tt.exporters) {
+				t.Errorf("Exporters() = %v, expected %v", exporters, tt.exporters)
+			}
+
+			if active := provider.active(); active == tt.noop {
+				t.Errorf("active() = %v, expected %v", active, !tt.noop)
+			}
+		})
+	}
+}
+
+func TestProviderLifecycle(t *testing.T) {
+	provider, err := NewProvider(&Config{OtelExporters: []string{"memory"}})
+	if err != nil {
+		t.Fatalf("NewProvider() error = %v", err)
+	}
+
+	_, span := provider.Tracer("test").Start(context.Background(), "span")
+	span.End()
+
+	if recorded := len(provider.GetRecordedSpans()); recorded != 1 {
+		t.Errorf("recorded %d spans, expected 1", recorded)
+	}
+
+	if stats := provider.Stats(); stats.ExportedSpans != 1 || stats.DroppedSpans != 0 {
+		t.Errorf("Stats() = %+v, expected 1 exported span", stats)
+	}
+
+	if err = provider.ForceFlush(context.Background()); err != nil {
+		t.Errorf("ForceFlush() error = %v", err)
+	}
+
+	if err = provider.Shutdown(context.Background()); err != nil {
+		t.Fatalf("Shutdown() error = %v", err)
+	}
+
+	if provider.active() {
+		t.Error("active() = true after Shutdown, expected false")
+	}
+}
+
+func TestProviderShutdownClosesEveryCloser(t *testing.T) {
+	closeErr := errors.New("close failed")
+	failingCloser := &testCloser{err: closeErr}
+	closer := &testCloser{}
+
+	provider := &Provider{closers: []io.Closer{failingCloser, closer}}
+
+	if err := provider.Shutdown(context.Background()); !errors.Is(err, closeErr) {
+		t.Errorf("Shutdown() error = %v, expected %v", err, closeErr)
+	}
+
+	if !failingCloser.closed || !closer.closed {
+		t.Errorf("closers closed = %v, %v, expected every closer to be closed", failingCloser.closed, closer.closed)
+	}
+}
+
+func TestNewProviderShutsDownExportersOnError(t *testing.T) {
+	exporter := &testExporter{}
+	factoryErr := errors.New("factory failed")
+
+	registerTestExporter(t, "test_lifecycle_created", func(*Config) (sdktrace.SpanExporter, error) {
+		return exporter, nil
+	})
+	registerTestExporter(t, "test_lifecycle_failed", func(*Config) (sdktrace.SpanExporter, error) {
+		return nil, factoryErr
+	})
+
+	_, err := NewProvider(&Config{OtelExporters: []string{"test_lifecycle_created", "test_lifecycle_failed"}})
+	if !errors.Is(err, factoryErr) {
+		t.Fatalf("NewProvider() error = %v, expected %v", err, factoryErr)
+	}
+
+	if !exporter.shutdown.Load() {
+		t.Error("created exporter was not shut down after NewProvider failed")
+	}
+}
+
+func TestInitializeTraceProviderLifecycle(t *testing.T) {
+	t.Cleanup(func() { _ = Reset() })
+
+	config := &Config{OtelExporters: []string{"memory"}}
+
+	if err := InitializeTraceProvider(config); err != nil {
+		t.Fatalf("InitializeTraceProvider() error = %v", err)
+	}
+
+	if err := InitializeTraceProvider(config); !errors.Is(err, ErrAlreadyInitialized) {
+		t.Errorf("InitializeTraceProvider() error = %v, expected %v", err, ErrAlreadyInitialized)
+	}
+
+	_, span := NewTracer("test").Start(context.Background(), "span")
+	span.End()
+
+	if recorded := len(GetRecordedSpans()); recorded != 1 {
+		t.Errorf("recorded %d spans, expected 1", recorded)
+	}
+
+	if err := ShutdownTraceProvider(context.Background()); err != nil {
+		t.Fatalf("ShutdownTraceProvider() error = %v", err)
+	}
+
+	if NewTracer("test") == nil {
+		t.Fatal("NewTracer() = nil after ShutdownTraceProvider, expected a no-op tracer")
+	}
+
+	if err := InitializeTraceProvider(config); err != nil {
+		t.Errorf("InitializeTraceProvider() after ShutdownTraceProvider error = %v", err)
+	}
+}
@@ -0,0 +1,247 @@
+package bobotel
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"runtime/debug"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/resource"
+	semconv "go.opentelemetry.io/otel/semconv/v1.39.0"
+)
+
+const (
+	// DeploymentEnvironmentAttributeKey defines the resource attributes key that maps to the semantic convention
+	// deployment environment name attribute.
+	DeploymentEnvironmentAttributeKey = "deployment.environment"
+	// SchemaURL defines the open-telemetry semantic convention schema url of the resources and attributes created by
+	// bobotel, which downstream tooling can use to reconcile attribute names.
+	SchemaURL = semconv.SchemaURL
+	// BuildVCSTimeAttributeKey defines the resource attributes key set by the 'build' resource detector to the commit
+	// time of the vcs revision the binary was built from.
+	BuildVCSTimeAttributeKey = "build.vcs.time"
+	// BuildVCSModifiedAttributeKey defines the resource attributes key set by the 'build' resource detector to whether
+	// the binary was built from a modified working tree.
+	BuildVCSModifiedAttributeKey = "build.vcs.modified"
+)
+
+// defaultServiceInstanceID is the service instance ID used by all provider resources created by the process when no
+// app ID is configured, so that replicas are distinguishable and traces, metrics, and logs share an instance ID.
+var defaultServiceInstanceID = sync.OnceValue(uuid.NewString)
+
+// resourceConfig defines the values used to build a provider resource.
+type resourceConfig struct {
+	appName            string
+	appID              string
+	serviceVersion     string
+	serviceNamespace   string
+	resourceAttributes map[string]string
+	resourceDetectors  []string
+	detectors          []resource.Detector
+	minimal            bool
+}
+
+// newProviderResource builds a provider resource where attributes from the optional resource detectors (built-in
+// detectors followed by custom detectors) are overridden by the default resource (including attributes from the
+// OTEL_RESOURCE_ATTRIBUTES and OTEL_SERVICE_NAME environment variables), which are in turn overridden by explicitly
+// configured attributes. A minimal resource omits the default resource, aside from the environment variable attributes.
+//
+// When no service name or service instance ID is configured or detected, the service name falls back to the binary
+// name (or the hostname), and the service instance ID falls back to a UUID generated once per process.
+//
+// Resources with conflicting schema urls do not fail provider initialization. Instead, the conflict is reported via the
+// open-telemetry error handler, and the merged attributes are used without a schema url.
+func newProviderResource(ctx context.Context, rc resourceConfig) (*resource.Resource, error) {
+	attributes := customResourceAttributes(rc.resourceAttributes)
+
+	if rc.appName != "" {
+		attributes = append(attributes, semconv.ServiceNameKey.String(rc.appName))
+	}
+
+	if rc.appID != "" {
+		attributes = append(attributes, semconv.ServiceInstanceIDKey.String(rc.appID))
+	}
+
+	if rc.serviceVersion != "" {
+		attributes = append(attributes, semconv.ServiceVersionKey.String(rc.serviceVersion))
+	}
+
+	if rc.serviceNamespace != "" {
+		attributes = append(attributes, semconv.ServiceNamespaceKey.String(rc.serviceNamespace))
+	}
+
+	baseResource, err := newBaseResource(ctx, rc.minimal)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rc.resourceDetectors) > 0 || len(rc.detectors) > 0 {
+		detectorOptions, err := resourceDetectorOptions(rc.resourceDetectors)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(rc.detectors) > 0 {
+			detectorOptions = append(detectorOptions, resource.WithDetectors(rc.detectors...))
+		}
+
+		detectedResource, err := resource.New(ctx, detectorOptions...)
+		if err != nil && !handleSchemaURLConflict(err, detectedResource) {
+			return nil, fmt.Errorf("problem detecting resource attributes: %w", err)
+		}
+
+		if baseResource, err = mergeResources(detectedResource, baseResource); err != nil {
+			return nil, err
+		}
+	}
+
+	attributes = append(fallbackResourceAttributes(baseResource), attributes...)
+
+	return mergeResources(baseResource, resource.NewWithAttributes(SchemaURL, attributes...))
+}
+
+// fallbackResourceAttributes returns the fallback service name and service instance ID attributes for those missing
+// from the given resource, where the open-telemetry 'unknown_service' default service name is considered missing.
+func fallbackResourceAttributes(r *resource.Resource) []attribute.KeyValue {
+	attributes := []attribute.KeyValue{}
+	set := r.Set()
+
+	if serviceName, ok := set.Value(semconv.ServiceNameKey); !ok || serviceName.AsString() == "" ||
+		strings.HasPrefix(serviceName.AsString(), "unknown_service") {
+		if name := defaultServiceName(); name != "" {
+			attributes = append(attributes, semconv.ServiceNameKey.String(name))
+		}
+	}
+
+	if instanceID, ok := set.Value(semconv.ServiceInstanceIDKey); !ok || instanceID.AsString() == "" {
+		attributes = append(attributes, semconv.ServiceInstanceIDKey.String(defaultServiceInstanceID()))
+	}
+
+	return attributes
+}
+
+// defaultServiceName returns the binary name, or the hostname if the binary name cannot be determined.
+func defaultServiceName() string {
+	if executable, err := os.Executable(); err == nil {
+		return filepath.Base(executable)
+	}
+
+	if hostname, err := os.Hostname(); err == nil {
+		return hostname
+	}
+
+	return ""
+}
+
+// newBaseResource returns the open-telemetry SDK default resource, or when minimal only the resource attributes from
+// the OTEL_RESOURCE_ATTRIBUTES and OTEL_SERVICE_NAME environment variables.
+func newBaseResource(ctx context.Context, minimal bool) (*resource.Resource, error) {
+	if !minimal {
+		return resource.Default(), nil
+	}
+
+	envResource, err := resource.New(ctx, resource.WithFromEnv())
+	if err != nil {
+		return nil, fmt.Errorf("problem detecting resource attributes: %w", err)
+	}
+
+	return envResource, nil
+}
+
+// mergeResources merges the given resources, where a schema url conflict is reported via the open-telemetry error
+// handler, and the merged resource without a schema url is returned.
+func mergeResources(a, b *resource.Resource) (*resource.Resource, error) {
+	merged, err := resource.Merge(a, b)
+	if err != nil && !handleSchemaURLConflict(err, merged) {
+		return nil, fmt.Errorf("problem merging resources: %w", err)
+	}
+
+	return merged, nil
+}
+
+// handleSchemaURLConflict reports the given error via the open-telemetry error handler if it is a schema url conflict,
+// and returns whether it was handled.
+func handleSchemaURLConflict(err error, r *resource.Resource) bool {
+	if r == nil || !errors.Is(err, resource.ErrSchemaURLConflict) {
+		return false
+	}
+
+	otel.Handle(fmt.Errorf("bobotel resource schema url conflict, using resource without schema url: %w", err))
+
+	return true
+}
+
+func resourceDetectorOptions(resourceDetectors []string) ([]resource.Option, error) {
+	opts := make([]resource.Option, 0, len(resourceDetectors))
+
+	for _, detector := range resourceDetectors {
+		switch detector {
+		case "host":
+			opts = append(opts, resource.WithHost())
+		case "process":
+			opts = append(opts, resource.WithProcess())
+		case "os":
+			opts = append(opts, resource.WithOS())
+		case "container":
+			opts = append(opts, resource.WithContainer())
+		case "build":
+			opts = append(opts, resource.WithDetectors(buildInfoDetector{}))
+		default:
+			return nil, fmt.Errorf("unsupported resource detector found: %s", detector)
+		}
+	}
+
+	return opts, nil
+}
+
+// buildInfoDetector detects the vcs revision, time, and modified state recorded in the build info of the binary, which
+// is only recorded when the binary is built from within a vcs repository (see 'go help buildvcs').
+type buildInfoDetector struct{}
+
+func (buildInfoDetector) Detect(context.Context) (*resource.Resource, error) {
+	buildInfo, ok := debug.ReadBuildInfo()
+	if !ok {
+		return resource.Empty(), nil
+	}
+
+	attributes := []attribute.KeyValue{}
+
+	for _, setting := range buildInfo.Settings {
+		switch setting.Key {
+		case "vcs.revision":
+			attributes = append(attributes, semconv.VCSRefHeadRevisionKey.String(setting.Value))
+		case "vcs.time":
+			attributes = append(attributes, attribute.String(BuildVCSTimeAttributeKey, setting.Value))
+		case "vcs.modified":
+			attributes = append(attributes, attribute.Bool(BuildVCSModifiedAttributeKey, setting.Value == "true"))
+		}
+	}
+
+	return resource.NewWithAttributes(SchemaURL, attributes...), nil
+}
+
+func customResourceAttributes(resourceAttributes map[string]string) []attribute.KeyValue {
+	attributes := make([]attribute.KeyValue, 0, len(resourceAttributes))
+
+	for _, key := range slices.Sorted(maps.Keys(resourceAttributes)) {
+		value := resourceAttributes[key]
+
+		switch key {
+		case DeploymentEnvironmentAttributeKey:
+			attributes = append(attributes, semconv.DeploymentEnvironmentNameKey.String(value))
+		default:
+			attributes = append(attributes, attribute.String(key, value))
+		}
+	}
+
+	return attributes
+}
@@ -0,0 +1,295 @@
+package bobotel
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"sync/atomic"
+
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// defaultSamplingHintAttribute defines the attribute key set by MarkForSampling when none is configured.
+const defaultSamplingHintAttribute = "sampling.priority"
+
+// MarkForSampling sets the sampling hint attribute (by default 'sampling.priority=1') configured for the trace
+// provider initialized via InitializeTraceProvider on the given span. Client-side sampling decisions are made when a
+// span starts, so the attribute is intended as a hint for a collector tail sampler to retain the marked trace.
+func MarkForSampling(span trace.Span) {
+	traceProviderLock.RLock()
+	defer traceProviderLock.RUnlock()
+
+	if singletonProvider == nil {
+		SetAttributes(span, attribute.Int(defaultSamplingHintAttribute, 1))
+
+		return
+	}
+
+	singletonProvider.MarkForSampling(span)
+}
+
+func newSamplingHintAttribute(c *Config) attribute.Key {
+	if c.OtelSamplingHintAttribute == "" {
+		return defaultSamplingHintAttribute
+	}
+
+	return attribute.Key(c.OtelSamplingHintAttribute)
+}
+
+// SetSamplingRatio sets the sampling ratio of the trace provider initialized via InitializeTraceProvider, which takes
+// effect for sampling decisions made after it returns. An error is returned if the trace provider is not initialized,
+// or if the configured sampler does not use a sampling ratio.
+func SetSamplingRatio(ratio float64) error {
+	traceProviderLock.RLock()
+	defer traceProviderLock.RUnlock()
+
+	if singletonProvider == nil {
+		return errors.New("trace provider not initialized")
+	}
+
+	return singletonProvider.SetSamplingRatio(ratio)
+}
+
+// newSampler creates the configured sampler, and the ratio sampler shared by the configured traceidratio samplers. The
+// returned ratio sampler is nil when the configured sampler does not use the sampler ratio.
+func newSampler(c *Config) (sdktrace.Sampler, *ratioSampler, error) {
+	var ratio *ratioSampler
+
+	if usesSamplerRatio(c) {
+		if err := otelSamplerRatioValidator(c.OtelSamplerRatio); err != nil {
+			return nil, nil, err
+		}
+
+		ratio = newRatioSampler(c.OtelSamplerRatio)
+	}
+
+	sampler, err := newConfiguredSampler(c, ratio)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(c.OtelSamplerRules) < 1 {
+		return sampler, ratio, nil
+	}
+
+	sampler, err = newRuleSampler(c.OtelSamplerRules, sampler)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return sampler, ratio, nil
+}
+
+// usesSamplerRatio returns whether the configured sampler or any of the configured parent samplers sample by the
+// sampler ratio.
+func usesSamplerRatio(c *Config) bool {
+	if c.OtelSampler == "traceidratio" || c.OtelSampler == "parentbased_traceidratio" {
+		return true
+	}
+
+	if c.OtelSampler != "" && c.OtelSampler != "parentbased_always_on" {
+		return false
+	}
+
+	for _, parentSampler := range []string{
+		c.OtelSamplerRemoteParentSampled,
+		c.OtelSamplerRemoteParentNotSampled,
+		c.OtelSamplerLocalParentSampled,
+		c.OtelSamplerLocalParentNotSampled,
+	} {
+		if parentSampler == "traceidratio" {
+			return true
+		}
+	}
+
+	return false
+}
+
+func newConfiguredSampler(c *Config, ratio *ratioSampler) (sdktrace.Sampler, error) {
+	switch c.OtelSampler {
+	case "", "parentbased_always_on":
+		parentBasedOptions, err := newParentBasedSamplerOptions(c, ratio)
+		if err != nil {
+			return nil, err
+		}
+
+		return sdktrace.ParentBased(sdktrace.AlwaysSample(), parentBasedOptions...), nil
+	case "always_on":
+		return sdktrace.AlwaysSample(), nil
+	case "always_off":
+		return sdktrace.NeverSample(), nil
+	case "traceidratio":
+		return ratio, nil
+	case "parentbased_traceidratio":
+		parentBasedOptions, err := newParentBasedSamplerOptions(c, ratio)
+		if err != nil {
+			return nil, err
+		}
+
+		return sdktrace.ParentBased(ratio, parentBasedOptions...), nil
+	default:
+		return nil, fmt.Errorf("unsupported sampler: %s", c.OtelSampler)
+	}
+}
+
+// newParentBasedSamplerOptions creates the parent based sampler options for the configured parent samplers, where
+// unset parent samplers fall back to the open-telemetry sdk defaults.
+func newParentBasedSamplerOptions(c *Config, ratio *ratioSampler) ([]sdktrace.ParentBasedSamplerOption, error) {
+	parentSamplers := []struct {
+		name   string
+		option func(sdktrace.Sampler) sdktrace.ParentBasedSamplerOption
+	}{
+		{name: c.OtelSamplerRemoteParentSampled, option: sdktrace.WithRemoteParentSampled},
+		{name: c.OtelSamplerRemoteParentNotSampled, option: sdktrace.WithRemoteParentNotSampled},
+		{name: c.OtelSamplerLocalParentSampled, option: sdktrace.WithLocalParentSampled},
+		{name: c.OtelSamplerLocalParentNotSampled, option: sdktrace.WithLocalParentNotSampled},
+	}
+
+	opts := []sdktrace.ParentBasedSamplerOption{}
+
+	for _, parentSampler := range parentSamplers {
+		if parentSampler.name == "" {
+			continue
+		}
+
+		sampler, err := newParentSampler(parentSampler.name, ratio)
+		if err != nil {
+			return nil, err
+		}
+
+		opts = append(opts, parentSampler.option(sampler))
+	}
+
+	return opts, nil
+}
+
+func newParentSampler(samplerName string, ratio *ratioSampler) (sdktrace.Sampler, error) {
+	switch samplerName {
+	case "always_on":
+		return sdktrace.AlwaysSample(), nil
+	case "always_off":
+		return sdktrace.NeverSample(), nil
+	case "traceidratio":
+		return ratio, nil
+	default:
+		return nil, fmt.Errorf("unsupported parent sampler: %s", samplerName)
+	}
+}
+
+// ratioSampler samples spans by trace ID in the same way as the open-telemetry trace ID ratio sampler, where the ratio
+// is read atomically at decision time so that it can be changed while the trace provider is in use.
+type ratioSampler struct {
+	ratio             atomic.Uint64
+	traceIDUpperBound atomic.Uint64
+}
+
+func newRatioSampler(ratio float64) *ratioSampler {
+	s := &ratioSampler{}
+	s.setRatio(ratio)
+
+	return s
+}
+
+func (s *ratioSampler) setRatio(ratio float64) {
+	s.traceIDUpperBound.Store(traceIDRatioUpperBound(ratio))
+	s.ratio.Store(math.Float64bits(ratio))
+}
+
+func (s *ratioSampler) ShouldSample(p sdktrace.SamplingParameters) sdktrace.SamplingResult {
+	decision := sdktrace.Drop
+
+	if traceIDWithinRatio(p.TraceID, s.traceIDUpperBound.Load()) {
+		decision = sdktrace.RecordAndSample
+	}
+
+	return sdktrace.SamplingResult{
+		Decision:   decision,
+		Tracestate: trace.SpanContextFromContext(p.ParentContext).TraceState(),
+	}
+}
+
+func (s *ratioSampler) Description() string {
+	return fmt.Sprintf("TraceIDRatioBased{%g}", math.Float64frombits(s.ratio.Load()))
+}
+
+// traceIDRatioUpperBound returns the trace ID upper bound used to select traces within the given ratio.
+func traceIDRatioUpperBound(ratio float64) uint64 {
+	if ratio >= 1 {
+		return math.MaxUint64
+	}
+
+	if ratio <= 0 {
+		return 0
+	}
+
+	return uint64(ratio * (1 << 63))
+}
+
+// traceIDWithinRatio returns whether the given trace ID is below the trace ID upper bound of a ratio.
+func traceIDWithinRatio(traceID trace.TraceID, traceIDUpperBound uint64) bool {
+	return binary.BigEndian.Uint64(traceID[8:16])>>1 < traceIDUpperBound
+}
+
+// ruleSampler samples spans matching a sampler rule with the ratio of the first matching rule, and samples all other
+// spans with the fallback sampler.
+type ruleSampler struct {
+	rules    []SamplerRule
+	samplers []sdktrace.Sampler
+	fallback sdktrace.Sampler
+}
+
+func newRuleSampler(rules []SamplerRule, fallback sdktrace.Sampler) (sdktrace.Sampler, error) {
+	samplers := make([]sdktrace.Sampler, 0, len(rules))
+
+	for idx, rule := range rules {
+		if err := otelSamplerRatioValidator(rule.Ratio); err != nil {
+			return nil, fmt.Errorf("invalid sampler rule %d: %w", idx, err)
+		}
+
+		samplers = append(samplers, sdktrace.TraceIDRatioBased(rule.Ratio))
+	}
+
+	return &ruleSampler{rules: rules, samplers: samplers, fallback: fallback}, nil
+}
+
+func (s *ruleSampler) ShouldSample(p sdktrace.SamplingParameters) sdktrace.SamplingResult {
+	for idx, rule := range s.rules {
+		if rule.matches(p) {
+			return s.samplers[idx].ShouldSample(p)
+		}
+	}
+
+	return s.fallback.ShouldSample(p)
+}
+
+func (s *ruleSampler) Description() string {
+	return fmt.Sprintf("RuleBased{rules:%d,fallback:%s}", len(s.rules), s.fallback.Description())
+}
+
+func (r SamplerRule) matches(p sdktrace.SamplingParameters) bool {
+	if !strings.HasPrefix(p.Name, r.SpanNamePrefix) {
+		return false
+	}
+
+	for key, value := range r.Attributes {
+		found := false
+
+		for _, attr := range p.Attributes {
+			if string(attr.Key) == key && attr.Value.Emit() == value {
+				found = true
+
+				break
+			}
+		}
+
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,45 @@
+package bobotel
+
+import (
+	"slices"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// SlowSpanAttributeKey defines the attribute key set to true on spans exceeding the configured slow span threshold.
+const SlowSpanAttributeKey = "slow"
+
+// newSlowSpanProcessor wraps the given span processor with a span processor that flags spans exceeding the configured
+// slow span threshold, and returns the given span processor when no threshold is configured.
+func newSlowSpanProcessor(c *Config, processor sdktrace.SpanProcessor) sdktrace.SpanProcessor {
+	if c.OtelSlowSpanThreshold <= 0 {
+		return processor
+	}
+
+	return &slowSpanProcessor{SpanProcessor: processor, threshold: c.OtelSlowSpanThreshold}
+}
+
+// slowSpanProcessor wraps a span processor, and flags ended spans exceeding the threshold before they are passed to
+// the wrapped span processor. Ended spans are read-only, so flagged spans are wrapped with the slow span attribute.
+type slowSpanProcessor struct {
+	sdktrace.SpanProcessor
+	threshold time.Duration
+}
+
+func (p *slowSpanProcessor) OnEnd(s sdktrace.ReadOnlySpan) {
+	if s.EndTime().Sub(s.StartTime()) > p.threshold {
+		s = slowSpan{ReadOnlySpan: s}
+	}
+
+	p.SpanProcessor.OnEnd(s)
+}
+
+type slowSpan struct {
+	sdktrace.ReadOnlySpan
+}
+
+func (s slowSpan) Attributes() []attribute.KeyValue {
+	return append(slices.Clone(s.ReadOnlySpan.Attributes()), attribute.Bool(SlowSpanAttributeKey, true))
+}
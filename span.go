@@ -0,0 +1,285 @@
+package bobotel
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ErrorIndexAttributeKey defines the attribute key set by RecordErrors on each recorded error event to the index of the
+// error within the recorded errors.
+const ErrorIndexAttributeKey = "error.index"
+
+var (
+	defaultStartOptionsLock sync.RWMutex
+	defaultStartOptions     = map[string][]trace.SpanStartOption{}
+)
+
+// StartSpan starts a span with the given span name using the tracer with the given tracer name. StartSpan returns a
+// no-op span if called before InitializeTraceProvider. Default start options set for the tracer name via
+// SetDefaultStartOptions are applied before the given options.
+func StartSpan(
+	ctx context.Context, tracerName, spanName string, opts ...trace.SpanStartOption,
+) (context.Context, trace.Span) {
+	if defaultOpts := tracerDefaultStartOptions(tracerName); len(defaultOpts) > 0 {
+		opts = slices.Concat(defaultOpts, opts)
+	}
+
+	return NewTracer(tracerName).Start(ctx, spanName, opts...)
+}
+
+// SetDefaultStartOptions sets the default start options applied to every span started via StartSpan (including
+// StartSpanWithLinks and WithSpan) with the given tracer name, e.g. a span kind or attributes common to every span of a
+// tracer. Options passed to StartSpan are applied after the defaults, and so take precedence over them. Calling
+// SetDefaultStartOptions without options removes the defaults for the tracer name. Spans started directly from a
+// tracer returned by NewTracer are unaffected.
+func SetDefaultStartOptions(tracerName string, opts ...trace.SpanStartOption) {
+	defaultStartOptionsLock.Lock()
+	defer defaultStartOptionsLock.Unlock()
+
+	if len(opts) < 1 {
+		delete(defaultStartOptions, tracerName)
+
+		return
+	}
+
+	defaultStartOptions[tracerName] = slices.Clone(opts)
+}
+
+func tracerDefaultStartOptions(tracerName string) []trace.SpanStartOption {
+	defaultStartOptionsLock.RLock()
+	defer defaultStartOptionsLock.RUnlock()
+
+	return defaultStartOptions[tracerName]
+}
+
+// StartNewRootSpan starts a span with the given span name using the tracer with the given tracer name as the root of a
+// new trace, ignoring any parent span in the given context, e.g. for decoupling a background job from the request that
+// scheduled it. The parent span can still be referenced by passing trace.WithLinks(trace.LinkFromContext(ctx)).
+func StartNewRootSpan(
+	ctx context.Context, tracerName, spanName string, opts ...trace.SpanStartOption,
+) (context.Context, trace.Span) {
+	return StartSpan(ctx, tracerName, spanName, slices.Concat(opts, []trace.SpanStartOption{trace.WithNewRoot()})...)
+}
+
+// StartSpanWithLinks starts a span with the given span name and links using the tracer with the given tracer name, e.g.
+// linking a span processing a batch of messages to the spans that produced each message. Links with an invalid span
+// context are skipped.
+func StartSpanWithLinks(
+	ctx context.Context, tracerName, spanName string, links []trace.Link, opts ...trace.SpanStartOption,
+) (context.Context, trace.Span) {
+	validLinks := make([]trace.Link, 0, len(links))
+
+	for _, link := range links {
+		if link.SpanContext.IsValid() {
+			validLinks = append(validLinks, link)
+		}
+	}
+
+	if len(validLinks) > 0 {
+		opts = slices.Concat(opts, []trace.SpanStartOption{trace.WithLinks(validLinks...)})
+	}
+
+	return StartSpan(ctx, tracerName, spanName, opts...)
+}
+
+// WithSpan runs the given function within a span with the given span name, started using the tracer with the given
+// tracer name. An error returned by the function is recorded on the span. If the function panics, the panic is
+// recorded on the span, and the span is ended before re-panicking.
+func WithSpan(ctx context.Context, tracerName, spanName string, fn func(ctx context.Context) error) (err error) {
+	ctx, span := StartSpan(ctx, tracerName, spanName)
+
+	defer RecoverAndRecord(span)
+
+	if err = fn(ctx); err != nil {
+		RecordError(span, err)
+	}
+
+	return err
+}
+
+// RecoverAndRecord is intended to be deferred, and recovers a panic, records it on the span as an error with a stack
+// trace, ends the span, and re-panics with the recovered value. The span is ended whether or not a panic occurred, so
+// a deferred RecoverAndRecord replaces a deferred span.End(). A nil span is ignored.
+func RecoverAndRecord(span trace.Span) {
+	r := recover()
+
+	// NOTE: the recovered value is re-panicked for a nil span, so that the original panic isn't masked
+	if span == nil {
+		if r != nil {
+			panic(r)
+		}
+
+		return
+	}
+
+	if r == nil {
+		span.End()
+
+		return
+	}
+
+	if span.IsRecording() {
+		span.RecordError(fmt.Errorf("panic: %v", r), trace.WithStackTrace(true))
+		span.SetStatus(codes.Error, fmt.Sprintf("panic: %v", r))
+	}
+
+	span.End()
+
+	panic(r)
+}
+
+// SpanFromContext returns the current span from the given context, or a no-op span if none exists.
+func SpanFromContext(ctx context.Context) trace.Span {
+	return trace.SpanFromContext(ctx)
+}
+
+// TraceIDFromContext returns the hex encoded trace ID of the span in the given context, or an empty string if the
+// context does not contain a valid span context.
+func TraceIDFromContext(ctx context.Context) string {
+	spanContext := trace.SpanContextFromContext(ctx)
+	if !spanContext.HasTraceID() {
+		return ""
+	}
+
+	return spanContext.TraceID().String()
+}
+
+// SpanIDFromContext returns the hex encoded span ID of the span in the given context, or an empty string if the
+// context does not contain a valid span context.
+func SpanIDFromContext(ctx context.Context) string {
+	spanContext := trace.SpanContextFromContext(ctx)
+	if !spanContext.HasSpanID() {
+		return ""
+	}
+
+	return spanContext.SpanID().String()
+}
+
+// SetAttributes is a helper function that sets attributes on a span.
+func SetAttributes(span trace.Span, attrs ...attribute.KeyValue) {
+	if span == nil || !span.IsRecording() {
+		return
+	}
+
+	span.SetAttributes(attrs...)
+}
+
+// ComputeIfRecording is a helper function that sets the attributes returned by the given function on a span, where the
+// function is only called if the span is recording, e.g. for avoiding expensive attribute computation for spans that
+// are not sampled.
+func ComputeIfRecording(span trace.Span, fn func() []attribute.KeyValue) {
+	if span == nil || !span.IsRecording() {
+		return
+	}
+
+	span.SetAttributes(fn()...)
+}
+
+// AddEvent is a helper function that adds an event with the given name and attributes to a span, timestamped at the
+// time of the call.
+func AddEvent(span trace.Span, name string, attrs ...attribute.KeyValue) {
+	AddEventAt(span, time.Now(), name, attrs...)
+}
+
+// AddEventAt is a helper function that adds an event with the given name and attributes to a span, timestamped at the
+// given time.
+func AddEventAt(span trace.Span, timestamp time.Time, name string, attrs ...attribute.KeyValue) {
+	if span == nil || !span.IsRecording() {
+		return
+	}
+
+	span.AddEvent(name, trace.WithTimestamp(timestamp), trace.WithAttributes(attrs...))
+}
+
+// RecordError is a helper function that attaches an error to a span.
+func RecordError(span trace.Span, err error) {
+	if span == nil || !span.IsRecording() {
+		return
+	}
+
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+}
+
+// RecordErrors is a helper function that attaches each of the given errors to a span, e.g. for the non-fatal errors
+// accumulated by a batch operation. Each error event has an 'error.index' attribute set to the index of the error
+// within the given errors, and nil errors are skipped. The span status is set to error only if at least one error is
+// recorded.
+func RecordErrors(span trace.Span, errs []error) {
+	if span == nil || !span.IsRecording() {
+		return
+	}
+
+	recorded := make([]error, 0, len(errs))
+
+	for idx, err := range errs {
+		if err == nil {
+			continue
+		}
+
+		span.RecordError(err, trace.WithAttributes(attribute.Int(ErrorIndexAttributeKey, idx)))
+		recorded = append(recorded, err)
+	}
+
+	if len(recorded) > 0 {
+		span.SetStatus(codes.Error, errors.Join(recorded...).Error())
+	}
+}
+
+// SetStatus is a helper function that sets the status of a span, e.g. codes.Ok for a successful operation.
+func SetStatus(span trace.Span, code codes.Code, description string) {
+	if span == nil || !span.IsRecording() {
+		return
+	}
+
+	span.SetStatus(code, description)
+}
+
+// RecordErrorOption defines an option for RecordErrorWithOptions.
+type RecordErrorOption func(*recordErrorOptions)
+
+type recordErrorOptions struct {
+	setStatus         bool
+	statusDescription string
+}
+
+// WithoutStatus prevents RecordErrorWithOptions from setting the span status, e.g. for handled or expected errors.
+func WithoutStatus() RecordErrorOption {
+	return func(o *recordErrorOptions) {
+		o.setStatus = false
+	}
+}
+
+// WithStatusDescription sets the span status description used by RecordErrorWithOptions in place of the error message.
+func WithStatusDescription(description string) RecordErrorOption {
+	return func(o *recordErrorOptions) {
+		o.statusDescription = description
+	}
+}
+
+// RecordErrorWithOptions is a helper function that attaches an error to a span. By default the span status is set to
+// error with the error message as the description, matching RecordError.
+func RecordErrorWithOptions(span trace.Span, err error, opts ...RecordErrorOption) {
+	if span == nil || !span.IsRecording() || err == nil {
+		return
+	}
+
+	options := recordErrorOptions{setStatus: true, statusDescription: err.Error()}
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	span.RecordError(err)
+
+	if options.setStatus {
+		span.SetStatus(codes.Error, options.statusDescription)
+	}
+}
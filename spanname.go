@@ -0,0 +1,48 @@
+package bobotel
+
+import (
+	"context"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// newSpanNameProcessor wraps the given span processor with a span processor that normalizes span names via the
+// configured span name normalizer, and returns the given span processor when no normalizer is configured.
+func newSpanNameProcessor(c *Config, processor sdktrace.SpanProcessor) sdktrace.SpanProcessor {
+	if c.SpanNameNormalizer == nil {
+		return processor
+	}
+
+	return &spanNameProcessor{SpanProcessor: processor, normalize: c.SpanNameNormalizer}
+}
+
+// spanNameProcessor wraps a span processor, and normalizes span names before spans are passed to the wrapped span
+// processor. Span names may be updated after a span is started, and ended spans are read-only, so ended spans are
+// wrapped with the normalized span name.
+type spanNameProcessor struct {
+	sdktrace.SpanProcessor
+	normalize func(name string) string
+}
+
+func (p *spanNameProcessor) OnStart(parent context.Context, s sdktrace.ReadWriteSpan) {
+	s.SetName(p.normalize(s.Name()))
+
+	p.SpanProcessor.OnStart(parent, s)
+}
+
+func (p *spanNameProcessor) OnEnd(s sdktrace.ReadOnlySpan) {
+	if name := p.normalize(s.Name()); name != s.Name() {
+		s = normalizedSpan{ReadOnlySpan: s, name: name}
+	}
+
+	p.SpanProcessor.OnEnd(s)
+}
+
+type normalizedSpan struct {
+	sdktrace.ReadOnlySpan
+	name string
+}
+
+func (s normalizedSpan) Name() string {
+	return s.name
+}
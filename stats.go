@@ -0,0 +1,66 @@
+package bobotel
+
+import (
+	"context"
+	"sync/atomic"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// SpanStats reports export counts of a trace provider. Spans are counted once per exporter, so a span exported to
+// multiple exporters is counted multiple times.
+type SpanStats struct {
+	// ExportedSpans is the number of spans successfully exported.
+	ExportedSpans uint64
+	// DroppedSpans is the number of spans dropped due to failed exports. Spans dropped by a batch span processor with
+	// a full queue are not reported by the open-telemetry sdk, and are not included.
+	DroppedSpans uint64
+}
+
+// TraceProviderStats returns the span stats of the trace provider initialized via InitializeTraceProvider. Zero stats
+// are returned if the trace provider is a no-op.
+func TraceProviderStats() SpanStats {
+	traceProviderLock.RLock()
+	defer traceProviderLock.RUnlock()
+
+	if singletonProvider != nil {
+		return singletonProvider.Stats()
+	}
+
+	return SpanStats{}
+}
+
+type spanStats struct {
+	exportedSpans atomic.Uint64
+	droppedSpans  atomic.Uint64
+}
+
+func (s *spanStats) snapshot() SpanStats {
+	if s == nil {
+		return SpanStats{}
+	}
+
+	return SpanStats{ExportedSpans: s.exportedSpans.Load(), DroppedSpans: s.droppedSpans.Load()}
+}
+
+// countingExporter wraps a span exporter, and counts the spans it exports.
+func (s *spanStats) countingExporter(exporter sdktrace.SpanExporter) sdktrace.SpanExporter {
+	return &countingExporter{SpanExporter: exporter, stats: s}
+}
+
+type countingExporter struct {
+	sdktrace.SpanExporter
+	stats *spanStats
+}
+
+func (e *countingExporter) ExportSpans(ctx context.Context, spans []sdktrace.ReadOnlySpan) error {
+	if err := e.SpanExporter.ExportSpans(ctx, spans); err != nil {
+		e.stats.droppedSpans.Add(uint64(len(spans)))
+
+		return err
+	}
+
+	e.stats.exportedSpans.Add(uint64(len(spans)))
+
+	return nil
+}
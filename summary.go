@@ -0,0 +1,103 @@
+package bobotel
+
+import (
+	"fmt"
+	"slices"
+
+	"go.opentelemetry.io/otel/sdk/resource"
+)
+
+// InitSummary describes the trace provider configured via InitializeTraceProvider, and is returned by
+// InitializeTraceProviderWithSummary.
+type InitSummary struct {
+	// Noop is true when a no-op trace provider was configured, which is the case when no exporters are configured.
+	Noop bool
+	// ResourceAttributes defines the attributes of the trace provider resource, and is nil when the trace provider
+	// failed open to a no-op trace provider.
+	ResourceAttributes map[string]string
+	// Exporters defines the configured exporters.
+	Exporters []string
+	// OtlpEndpoints defines the otlp endpoints exported to when the 'otlp' exporter is configured.
+	OtlpEndpoints []OtlpEndpointSummary
+	// FallbackExporter defines the exporter that otlp spans are routed to when the 'otlp' exporter fails, and is empty
+	// when no fallback exporter is configured.
+	FallbackExporter string
+	// Sampler defines the configured sampler.
+	Sampler string
+	// SpanProcessor defines the span processor used by exporters.
+	SpanProcessor string
+	// Propagators defines the configured propagators.
+	Propagators []string
+}
+
+// OtlpEndpointSummary describes an otlp endpoint configured for the 'otlp' exporter.
+type OtlpEndpointSummary struct {
+	// Kind defines the endpoint kind ('http' or 'grpc').
+	Kind string
+	// Endpoint defines the endpoint url if configured, and otherwise the endpoint host and port.
+	Endpoint string
+}
+
+func newInitSummary(c *Config, options providerOptions) InitSummary {
+	summary := InitSummary{
+		Noop:          len(c.OtelExporters) < 1 && len(options.spanProcessors) < 1,
+		Exporters:     slices.Clone(c.OtelExporters),
+		Sampler:       c.OtelSampler,
+		SpanProcessor: c.OtelSpanProcessor,
+		Propagators:   slices.Clone(c.OtelPropagators),
+	}
+
+	if options.sampler != nil {
+		summary.Sampler = options.sampler.Description()
+	}
+
+	if summary.Sampler == "" {
+		summary.Sampler = "parentbased_always_on"
+	}
+
+	if summary.SpanProcessor == "" {
+		summary.SpanProcessor = "batch"
+	}
+
+	if len(summary.Propagators) < 1 {
+		summary.Propagators = slices.Clone(defaultPropagators)
+	}
+
+	if slices.Contains(c.OtelExporters, "otlp") {
+		summary.FallbackExporter = c.OtelFallbackExporter
+		summary.OtlpEndpoints = append(summary.OtlpEndpoints, newOtlpEndpointSummary(c))
+
+		for _, endpoint := range c.OtlpEndpoints {
+			endpointSummary := newOtlpEndpointSummary(otlpEndpointConfig(c, endpoint))
+			summary.OtlpEndpoints = append(summary.OtlpEndpoints, endpointSummary)
+		}
+	}
+
+	return summary
+}
+
+func newResourceAttributesSummary(r *resource.Resource) map[string]string {
+	if r == nil {
+		return nil
+	}
+
+	attributes := make(map[string]string, r.Len())
+
+	for _, attr := range r.Attributes() {
+		attributes[string(attr.Key)] = attr.Value.Emit()
+	}
+
+	return attributes
+}
+
+func newOtlpEndpointSummary(c *Config) OtlpEndpointSummary {
+	c = otlpEnvConfig(c)
+
+	if c.OtlpEndpointURL != "" {
+		return OtlpEndpointSummary{Kind: c.OtlpEndpointKind, Endpoint: c.OtlpEndpointURL}
+	}
+
+	endpoint := fmt.Sprintf("%s:%d", c.OtlpHost, otlpPort(c.OtlpEndpointKind, c.OtlpPort))
+
+	return OtlpEndpointSummary{Kind: c.OtlpEndpointKind, Endpoint: endpoint}
+}
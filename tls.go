@@ -0,0 +1,41 @@
+package bobotel
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+)
+
+func newTLSConfig(c *Config) (*tls.Config, error) {
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+
+	if c.OtlpCACertPath != "" {
+		caCert, err := os.ReadFile(c.OtlpCACertPath)
+		if err != nil {
+			return nil, fmt.Errorf("problem reading otlp ca cert: %w", err)
+		}
+
+		certPool := x509.NewCertPool()
+		if ok := certPool.AppendCertsFromPEM(caCert); !ok {
+			return nil, fmt.Errorf("problem parsing otlp ca cert: no certificates found in '%s'", c.OtlpCACertPath)
+		}
+
+		tlsConfig.RootCAs = certPool
+	}
+
+	switch {
+	case c.OtlpClientCertPath != "" && c.OtlpClientKeyPath != "":
+		clientCert, err := tls.LoadX509KeyPair(c.OtlpClientCertPath, c.OtlpClientKeyPath)
+		if err != nil {
+			return nil, fmt.Errorf("problem loading otlp client cert: %w", err)
+		}
+
+		tlsConfig.Certificates = []tls.Certificate{clientCert}
+	case c.OtlpClientCertPath != "" || c.OtlpClientKeyPath != "":
+		return nil, errors.New("otlp client cert path and client key path must be provided together")
+	}
+
+	return tlsConfig, nil
+}
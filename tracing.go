@@ -2,41 +2,125 @@ package bobotel
 
 import (
 	"context"
+	"crypto/tls"
 	"errors"
 	"fmt"
+	"io"
 	"os"
+	"slices"
+	"strconv"
+	"strings"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"go.opentelemetry.io/otel"
-	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
 	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
-	"go.opentelemetry.io/otel/sdk/resource"
+	"go.opentelemetry.io/otel/propagation"
 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
-	semconv "go.opentelemetry.io/otel/semconv/v1.39.0"
 	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/embedded"
 	"go.opentelemetry.io/otel/trace/noop"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	grpcinsecure "google.golang.org/grpc/credentials/insecure"
 )
 
+// ErrAlreadyInitialized is returned by InitializeTraceProvider when a trace provider with exporters is already active.
+var ErrAlreadyInitialized = errors.New("trace provider already initialized")
+
 var (
-	traceProviderLock      sync.RWMutex
-	singletonTraceProvider trace.TracerProvider
-	configLock             sync.RWMutex
-	defaultConfig          *Config
+	traceProviderLock sync.RWMutex
+	singletonProvider *Provider
+	configLock        sync.RWMutex
+	defaultConfig     *Config
+	tracerCache       atomic.Pointer[sync.Map]
 )
 
 // NewTracer creates an open-telemetry tracer with the given name and options. NewTracer must be called after
-// InitializeTraceProvider in order to not receive a no-op tracer.
+// InitializeTraceProvider in order to not receive a no-op tracer. Tracers are cached by name and options until the
+// trace provider is re-initialized, so repeated calls return the same tracer.
 func NewTracer(tracerName string, options ...trace.TracerOption) trace.Tracer {
+	key := newTracerCacheKey(tracerName, options)
+
+	if tracer, found := cachedTracers().Load(key); found {
+		return tracer.(trace.Tracer)
+	}
+
 	traceProviderLock.RLock()
 	defer traceProviderLock.RUnlock()
 
-	if singletonTraceProvider != nil {
-		return singletonTraceProvider.Tracer(tracerName, options...)
+	var tracer trace.Tracer
+
+	if singletonProvider != nil {
+		tracer = singletonProvider.Tracer(tracerName, options...)
 	} else {
-		return NewNoopTracer(tracerName, options...)
+		tracer = NewNoopTracer(tracerName, options...)
 	}
+
+	// NOTE: the cache is only replaced while holding the write lock, so the tracer is stored in the cache belonging to
+	// the provider it was created from
+	cachedTracer, _ := cachedTracers().LoadOrStore(key, tracer)
+
+	return cachedTracer.(trace.Tracer)
+}
+
+// tracerCacheKey identifies a tracer by name and the comparable values of its tracer options.
+type tracerCacheKey struct {
+	tracerName string
+	version    string
+	schemaURL  string
+	attributes attribute.Distinct
+}
+
+func newTracerCacheKey(tracerName string, options []trace.TracerOption) tracerCacheKey {
+	tracerConfig := trace.NewTracerConfig(options...)
+	attributes := tracerConfig.InstrumentationAttributes()
+
+	return tracerCacheKey{
+		tracerName: tracerName,
+		version:    tracerConfig.InstrumentationVersion(),
+		schemaURL:  tracerConfig.SchemaURL(),
+		attributes: attributes.Equivalent(),
+	}
+}
+
+func cachedTracers() *sync.Map {
+	if tracers := tracerCache.Load(); tracers != nil {
+		return tracers
+	}
+
+	tracerCache.CompareAndSwap(nil, &sync.Map{})
+
+	return tracerCache.Load()
+}
+
+// lazyTracerProvider is a trace.TracerProvider for instrumentation libraries that resolves tracers with a fixed tracer
+// name from the trace provider initialized via InitializeTraceProvider each time a span is started. This allows
+// instrumentation to be created before InitializeTraceProvider is called.
+type lazyTracerProvider struct {
+	embedded.TracerProvider
+	tracerName string
+}
+
+func (p lazyTracerProvider) Tracer(_ string, options ...trace.TracerOption) trace.Tracer {
+	return lazyTracer{tracerName: p.tracerName, options: options}
+}
+
+type lazyTracer struct {
+	embedded.Tracer
+	tracerName string
+	options    []trace.TracerOption
+}
+
+func (t lazyTracer) Start(
+	ctx context.Context, spanName string, opts ...trace.SpanStartOption,
+) (context.Context, trace.Span) {
+	return NewTracer(t.tracerName, t.options...).Start(ctx, spanName, opts...)
 }
 
 // NewNoopTracer creates a no-op tracer with the given name.
@@ -44,144 +128,607 @@ func NewNoopTracer(tracerName string, options ...trace.TracerOption) trace.Trace
 	return noop.NewTracerProvider().Tracer(tracerName, options...)
 }
 
-// InitializeTraceProvider initializes an open-telemetry trace provider configured via the given TracerConfig.
+// InitializeTraceProvider initializes an open-telemetry trace provider configured via the given Config, where the
+// default Config set via NewConfig is used when no Config is provided. See InitializeTraceProviderWithOptions for
+// customizing the trace provider beyond the fields exposed by Config.
+//
+// InitializeTraceProvider returns ErrAlreadyInitialized if a previously initialized trace provider with exporters has
+// not been shut down via ShutdownTraceProvider, which prevents the previous provider from being leaked. A previously
+// initialized no-op trace provider is replaced.
 func InitializeTraceProvider(config ...*Config) error {
-	var c *Config
+	return InitializeTraceProviderWithContext(context.Background(), config...)
+}
+
+// InitializeTraceProviderWithContext initializes an open-telemetry trace provider configured via the given Config. The
+// given context is used while creating exporters, and can be used to bound or cancel exporter connection setup.
+func InitializeTraceProviderWithContext(ctx context.Context, config ...*Config) error {
+	opts := []Option{}
 
 	if len(config) > 0 {
-		c = config[0]
-	} else {
-		configLock.RLock()
-		defer configLock.RUnlock()
+		opts = append(opts, config[0])
+	}
+
+	_, err := InitializeTraceProviderWithSummary(ctx, opts...)
+
+	return err
+}
 
+// InitializeTraceProviderWithOptions initializes an open-telemetry trace provider configured via the given Config and
+// options (e.g. InitializeTraceProviderWithOptions(config, bobotel.WithSampler(sampler))), where the default Config set
+// via NewConfig is used when the given Config is nil.
+func InitializeTraceProviderWithOptions(c *Config, opts ...Option) error {
+	if c != nil {
+		opts = append([]Option{c}, opts...)
+	}
+
+	_, err := InitializeTraceProviderWithSummary(context.Background(), opts...)
+
+	return err
+}
+
+// InitializeTraceProviderWithSummary initializes an open-telemetry trace provider configured via the given Config and
+// options, and returns a summary of the configured trace provider (resource attributes, exporters, sampler, and
+// endpoints), e.g. for logging the telemetry configuration at startup. The given context is used while creating
+// exporters, and can be used to bound or cancel exporter connection setup.
+func InitializeTraceProviderWithSummary(ctx context.Context, opts ...Option) (InitSummary, error) {
+	options := newProviderOptions(opts)
+	c := options.config
+
+	if !options.configProvided {
+		configLock.RLock()
 		c = defaultConfig
+		configLock.RUnlock()
 	}
 
 	if c == nil {
-		return errors.New("no trace provider configuration provided or found")
+		return InitSummary{}, errors.New("no trace provider configuration provided or found")
 	}
 
-	providerResource, err := resource.Merge(
-		resource.Default(),
-		resource.NewWithAttributes(
-			semconv.SchemaURL,
-			semconv.ServiceNameKey.String(c.AppName),
-			semconv.ServiceInstanceIDKey.String(c.AppID),
-		),
-	)
+	provider, failedOpen, err := initializeSingletonProvider(ctx, c, options)
 	if err != nil {
-		return fmt.Errorf("problem creating tracer provider resources: %w", err)
+		return InitSummary{}, err
 	}
 
-	opts := []sdktrace.TracerProviderOption{sdktrace.WithResource(providerResource)}
+	summary := newInitSummary(c, options)
+	summary.ResourceAttributes = newResourceAttributesSummary(provider.resource)
 
-	if len(c.OtelExporters) < 1 {
-		traceProviderLock.Lock()
-		defer traceProviderLock.Unlock()
+	if failedOpen {
+		summary.Noop = true
+		summary.Exporters = []string{}
+		summary.OtlpEndpoints = nil
+		summary.FallbackExporter = ""
+	}
 
-		singletonTraceProvider = noop.NewTracerProvider()
+	// NOTE: the callback is called after releasing the trace provider lock, so that it can create tracers
+	if c.OnInitialize != nil {
+		c.OnInitialize(summary)
+	}
 
-		return nil
+	return summary, nil
+}
+
+// initializeSingletonProvider initializes the singleton trace provider, and returns the initialized provider and
+// whether it failed open to a no-op trace provider.
+func initializeSingletonProvider(ctx context.Context, c *Config, options providerOptions) (*Provider, bool, error) {
+	traceProviderLock.Lock()
+	defer traceProviderLock.Unlock()
+
+	if singletonProvider != nil && singletonProvider.active() {
+		return nil, false, ErrAlreadyInitialized
 	}
 
-	for _, exporter := range c.OtelExporters {
-		switch exporter {
-		case "console":
-			consoleExporter, err := newConsoleExporter(c)
-			if err != nil {
-				return fmt.Errorf("problem creating tracer console exporter: %w", err)
-			}
+	if c.OtelErrorHandler != nil {
+		otel.SetErrorHandler(otel.ErrorHandlerFunc(c.OtelErrorHandler))
+	}
 
-			opts = append(opts, sdktrace.WithBatcher(consoleExporter))
-		case "otlp":
-			otlpExporter, err := newOtlpExporter(c)
-			if err != nil {
-				return fmt.Errorf("problem creating tracer otlp exporter: %w", err)
-			}
+	failedOpen := false
 
-			opts = append(opts, sdktrace.WithBatcher(otlpExporter))
-		default:
-			return fmt.Errorf("unsupported exporter found: %s", exporter)
+	provider, err := newProvider(ctx, c, options)
+	if err != nil {
+		if !c.OtelFailOpen {
+			return nil, false, err
 		}
-	}
 
-	traceProviderLock.Lock()
-	defer traceProviderLock.Unlock()
+		otel.Handle(fmt.Errorf("bobotel trace provider failed open, using no-op trace provider: %w", err))
 
-	provider := sdktrace.NewTracerProvider(opts...)
-	singletonTraceProvider = provider
+		failedOpen = true
+		provider = newFailOpenProvider(c)
+	}
+
+	singletonProvider = provider
+	lastLeakedSpans = provider.leakedSpans
+	tracerCache.Store(&sync.Map{})
 
 	// Register as the global OTEL trace provider so callers using
 	// otel.Tracer() (not just bobotel.NewTracer()) get real spans.
-	otel.SetTracerProvider(provider)
+	if provider.sdkProvider != nil && c.OtelSetGlobal {
+		otel.SetTracerProvider(provider.tracerProvider)
+	}
+
+	otel.SetTextMapPropagator(provider.propagator)
+
+	return provider, failedOpen, nil
+}
+
+// IsInitialized returns whether a trace provider with exporters was initialized via InitializeTraceProvider, and has
+// not been shut down. False is returned when the no-op trace provider is in use.
+func IsInitialized() bool {
+	traceProviderLock.RLock()
+	defer traceProviderLock.RUnlock()
+
+	return singletonProvider != nil && singletonProvider.active()
+}
+
+// ActiveExporters returns the names of the exporters installed by the last successful InitializeTraceProvider, e.g. for
+// reporting the telemetry configuration from a debug endpoint. Nil is returned before the trace provider is
+// initialized, after it is shut down, or when it failed open to a no-op trace provider.
+func ActiveExporters() []string {
+	traceProviderLock.RLock()
+	defer traceProviderLock.RUnlock()
+
+	if singletonProvider == nil {
+		return nil
+	}
+
+	return singletonProvider.Exporters()
+}
+
+// ForceFlushTraceProvider exports any pending spans of the trace provider initialized via InitializeTraceProvider. Nil
+// is returned if the trace provider is a no-op.
+func ForceFlushTraceProvider(ctx context.Context) error {
+	traceProviderLock.RLock()
+	defer traceProviderLock.RUnlock()
+
+	if singletonProvider != nil {
+		return singletonProvider.ForceFlush(ctx)
+	}
 
 	return nil
 }
 
-// ShutdownTraceProvider ...
+// ShutdownTraceProvider flushes any pending spans and shuts down the trace provider initialized via
+// InitializeTraceProvider. After a successful shutdown, the trace provider is reset so that NewTracer returns no-op
+// tracers until InitializeTraceProvider is called again.
 func ShutdownTraceProvider(ctx context.Context) error {
 	traceProviderLock.Lock()
 	defer traceProviderLock.Unlock()
 
-	if sdkTraceProvider, ok := singletonTraceProvider.(*sdktrace.TracerProvider); ok {
-		_ = sdkTraceProvider.ForceFlush(ctx)
+	if singletonProvider == nil {
+		return nil
+	}
 
-		if err := sdkTraceProvider.Shutdown(ctx); err != nil {
-			return fmt.Errorf("problem shutting down trace provider: %w", err)
-		}
+	if err := singletonProvider.Shutdown(ctx); err != nil {
+		return err
+	}
 
-		return nil
+	// NOTE: the global trace provider is only reset if it is still the provider registered by InitializeTraceProvider
+	if otel.GetTracerProvider() == singletonProvider.tracerProvider {
+		otel.SetTracerProvider(noop.NewTracerProvider())
 	}
 
+	singletonProvider = nil
+	tracerCache.Store(&sync.Map{})
+
 	return nil
 }
 
-// RecordError is a helper function that attaches an error to a span.
-func RecordError(span trace.Span, err error) {
-	if span == nil || !span.IsRecording() {
-		return
+// ShutdownTraceProviderWithTimeout flushes any pending spans and shuts down the trace provider initialized via
+// InitializeTraceProvider, bounded by the given timeout.
+func ShutdownTraceProviderWithTimeout(timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	return ShutdownTraceProvider(ctx)
+}
+
+// Reset shuts down the trace provider initialized via InitializeTraceProvider, and resets package level state (the
+// default config set via NewConfig, default start options, and the global propagator), so that the package can be
+// initialized again, e.g. between tests within a single test binary. The trace provider is cleared even if shutting it
+// down fails, in which case the shutdown error is returned.
+func Reset() error {
+	traceProviderLock.Lock()
+
+	var err error
+
+	if singletonProvider != nil {
+		err = singletonProvider.Shutdown(context.Background())
+
+		if otel.GetTracerProvider() == singletonProvider.tracerProvider {
+			otel.SetTracerProvider(noop.NewTracerProvider())
+		}
+
+		singletonProvider = nil
+		tracerCache.Store(&sync.Map{})
 	}
 
-	span.RecordError(err)
-	span.SetStatus(codes.Error, err.Error())
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator())
+
+	traceProviderLock.Unlock()
+
+	configLock.Lock()
+	defaultConfig = nil
+	configLock.Unlock()
+
+	defaultStartOptionsLock.Lock()
+	defaultStartOptions = map[string][]trace.SpanStartOption{}
+	defaultStartOptionsLock.Unlock()
+
+	return err
+}
+
+func newSpanProcessorOption(
+	c *Config, exporterName string, exporter sdktrace.SpanExporter, batchOptions []sdktrace.BatchSpanProcessorOption,
+) sdktrace.TracerProviderOption {
+	var processor sdktrace.SpanProcessor
+
+	if exporterSpanProcessor(c, exporterName) == "simple" {
+		processor = sdktrace.NewSimpleSpanProcessor(exporter)
+	} else {
+		processor = sdktrace.NewBatchSpanProcessor(exporter, batchOptions...)
+	}
+
+	return sdktrace.WithSpanProcessor(newSpanNameProcessor(c, newSlowSpanProcessor(c, processor)))
+}
+
+// exporterSpanProcessor returns the span processor configured for the given exporter, falling back to the configured
+// span processor.
+func exporterSpanProcessor(c *Config, exporterName string) string {
+	for _, exporterSpanProcessor := range c.OtelExporterSpanProcessors {
+		if name, spanProcessor, _ := strings.Cut(exporterSpanProcessor, "="); name == exporterName {
+			return spanProcessor
+		}
+	}
+
+	return c.OtelSpanProcessor
+}
+
+func newBatchSpanProcessorOptions(c *Config) []sdktrace.BatchSpanProcessorOption {
+	opts := []sdktrace.BatchSpanProcessorOption{}
+
+	if c.OtelBatchTimeout > 0 {
+		opts = append(opts, sdktrace.WithBatchTimeout(c.OtelBatchTimeout))
+	}
+
+	if c.OtelExportTimeout > 0 {
+		opts = append(opts, sdktrace.WithExportTimeout(c.OtelExportTimeout))
+	}
+
+	if c.OtelMaxExportBatchSize > 0 {
+		opts = append(opts, sdktrace.WithMaxExportBatchSize(c.OtelMaxExportBatchSize))
+	}
+
+	if c.OtelMaxQueueSize > 0 {
+		opts = append(opts, sdktrace.WithMaxQueueSize(c.OtelMaxQueueSize))
+	}
+
+	return opts
+}
+
+// newSpanLimits creates span limits where unset (zero) config values fall back to the open-telemetry sdk defaults.
+func newSpanLimits(c *Config) sdktrace.SpanLimits {
+	limits := sdktrace.NewSpanLimits()
+
+	if c.SpanLimitsMaxAttributesPerSpan > 0 {
+		limits.AttributeCountLimit = c.SpanLimitsMaxAttributesPerSpan
+	}
+
+	if c.SpanLimitsMaxEventsPerSpan > 0 {
+		limits.EventCountLimit = c.SpanLimitsMaxEventsPerSpan
+	}
+
+	if c.SpanLimitsMaxLinksPerSpan > 0 {
+		limits.LinkCountLimit = c.SpanLimitsMaxLinksPerSpan
+	}
+
+	if c.SpanLimitsMaxAttributeValueLength > 0 {
+		limits.AttributeValueLengthLimit = c.SpanLimitsMaxAttributeValueLength
+	}
+
+	return limits
 }
 
 func newConsoleExporter(c *Config) (sdktrace.SpanExporter, error) {
-	if c.OtelConsoleFormat == "production" {
-		return stdouttrace.New(
-			stdouttrace.WithWriter(os.Stdout),
-		)
+	writer := newConsoleOutputWriter(c.OtelConsoleOutput)
+	if c.OtelConsoleWriter != nil {
+		writer = c.OtelConsoleWriter
+	}
+
+	var exporter sdktrace.SpanExporter
+
+	if c.OtelConsoleFormat == "logfmt" {
+		exporter = newLogfmtExporter(writer)
+	} else {
+		opts := []stdouttrace.Option{stdouttrace.WithWriter(writer)}
+
+		// NOTE: the json encoder used by the exporter outputs a single-line JSON object per write unless pretty-printed
+		if c.OtelConsoleFormat != "production" && c.OtelConsoleFormat != "json" {
+			opts = append(opts, stdouttrace.WithPrettyPrint())
+		}
+
+		stdoutExporter, err := stdouttrace.New(opts...)
+		if err != nil {
+			return nil, err
+		}
+
+		exporter = stdoutExporter
+	}
+
+	return exporter, nil
+}
+
+// reportUnusedConsoleFields reports console format and output values other than the defaults via the open-telemetry
+// error handler when no 'console' trace exporter or console fallback exporter is configured, as the values have no
+// effect on traces, so that the misconfiguration is not mistaken for a working setup.
+func reportUnusedConsoleFields(c *Config) {
+	if slices.Contains(c.OtelExporters, "console") ||
+		(c.OtelFallbackExporter == "console" && slices.Contains(c.OtelExporters, "otlp")) {
+		return
+	}
+
+	if c.OtelConsoleFormat != "" && c.OtelConsoleFormat != "production" {
+		otel.Handle(fmt.Errorf(
+			"bobotel '%s.%s' value '%s' has no effect on traces without a 'console' exporter",
+			OtelFieldSetKey, OtelConsoleFormatKey, c.OtelConsoleFormat,
+		))
+	}
+
+	if c.OtelConsoleOutput != "" && c.OtelConsoleOutput != "stdout" {
+		otel.Handle(fmt.Errorf(
+			"bobotel '%s.%s' value '%s' has no effect on traces without a 'console' exporter",
+			OtelFieldSetKey, OtelConsoleOutputKey, c.OtelConsoleOutput,
+		))
+	}
+}
+
+// newConsoleOutputWriter returns the output stream that 'console' exporters write to, which is stdout unless 'stderr'
+// is configured.
+func newConsoleOutputWriter(output string) io.Writer {
+	if output == "stderr" {
+		return os.Stderr
+	}
+
+	return os.Stdout
+}
+
+// minDurationExporter wraps a span exporter, and drops spans shorter than the minimum duration before exporting.
+type minDurationExporter struct {
+	sdktrace.SpanExporter
+	minDuration time.Duration
+}
+
+func newMinDurationExporter(exporter sdktrace.SpanExporter, minDuration time.Duration) sdktrace.SpanExporter {
+	if minDuration <= 0 {
+		return exporter
+	}
+
+	return &minDurationExporter{SpanExporter: exporter, minDuration: minDuration}
+}
+
+func (e *minDurationExporter) ExportSpans(ctx context.Context, spans []sdktrace.ReadOnlySpan) error {
+	filteredSpans := make([]sdktrace.ReadOnlySpan, 0, len(spans))
+
+	for _, span := range spans {
+		if span.EndTime().Sub(span.StartTime()) >= e.minDuration {
+			filteredSpans = append(filteredSpans, span)
+		}
+	}
+
+	if len(filteredSpans) < 1 {
+		return nil
+	}
+
+	return e.SpanExporter.ExportSpans(ctx, filteredSpans)
+}
+
+// traceIDRatioExporter wraps a span exporter, and drops spans of traces outside of the ratio before exporting. Traces
+// are selected by trace ID in the same way as the open-telemetry trace ID ratio sampler, so whole traces are kept.
+type traceIDRatioExporter struct {
+	sdktrace.SpanExporter
+	traceIDUpperBound uint64
+}
+
+// newTraceIDRatioExporter wraps the given span exporter with a traceIDRatioExporter, where a ratio of 0 drops every
+// span, and the given span exporter is returned for a ratio of 1.
+func newTraceIDRatioExporter(exporter sdktrace.SpanExporter, ratio float64) sdktrace.SpanExporter {
+	if ratio >= 1 {
+		return exporter
+	}
+
+	return &traceIDRatioExporter{SpanExporter: exporter, traceIDUpperBound: traceIDRatioUpperBound(ratio)}
+}
+
+func (e *traceIDRatioExporter) ExportSpans(ctx context.Context, spans []sdktrace.ReadOnlySpan) error {
+	filteredSpans := make([]sdktrace.ReadOnlySpan, 0, len(spans))
+
+	for _, span := range spans {
+		if traceIDWithinRatio(span.SpanContext().TraceID(), e.traceIDUpperBound) {
+			filteredSpans = append(filteredSpans, span)
+		}
+	}
+
+	if len(filteredSpans) < 1 {
+		return nil
+	}
+
+	return e.SpanExporter.ExportSpans(ctx, filteredSpans)
+}
+
+// parseOtlpSampleRatio parses the otlp sample ratio, where an unset (empty) ratio exports every sampled span.
+func parseOtlpSampleRatio(value string) (float64, error) {
+	if value == "" {
+		return 1, nil
+	}
+
+	ratio, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid sample ratio value: '%s', expected a value between 0.0 and 1.0", value)
+	}
+
+	if err = otelSamplerRatioValidator(ratio); err != nil {
+		return 0, err
 	}
 
-	return stdouttrace.New(
-		stdouttrace.WithWriter(os.Stdout),
-		stdouttrace.WithPrettyPrint(),
-	)
+	return ratio, nil
 }
 
-func newOtlpExporter(c *Config) (sdktrace.SpanExporter, error) {
+func newOtlpExporter(ctx context.Context, c *Config) (sdktrace.SpanExporter, error) {
+	client, err := newOtlpClient(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+
+	exporter, err := otlptrace.New(ctx, client)
+	if err != nil {
+		return nil, fmt.Errorf("problem creating otlp exporter: %w", err)
+	}
+
+	return exporter, nil
+}
+
+// newOtlpClient creates the otlp client used by the otlp exporter, which uploads export requests to the configured
+// endpoint.
+func newOtlpClient(ctx context.Context, c *Config) (otlptrace.Client, error) {
 	// NOTE: default http port is 4318, default grpc port is 4317
-	var exporter sdktrace.SpanExporter
+	var client otlptrace.Client
 	var err error
 
+	c = otlpEnvConfig(c)
+
+	insecure, err := otlpInsecure(c)
+	if err != nil {
+		return nil, err
+	}
+
+	if c.OtlpHTTPEncoding == "json" {
+		if c.OtlpEndpointKind != "http" {
+			return nil, fmt.Errorf("otlp http encoding 'json' unsupported by endpoint kind: %s", c.OtlpEndpointKind)
+		}
+
+		client, err = newOtlpJSONClient(c, insecure)
+		if err != nil {
+			return nil, fmt.Errorf("problem creating otlp exporter: %w", err)
+		}
+
+		return client, nil
+	}
+
 	switch c.OtlpEndpointKind {
 	case "http":
-		exporter, err = otlptracehttp.New(
-			context.Background(),
-			otlptracehttp.WithEndpoint(fmt.Sprintf("%s:%d", c.OtlpHost, c.OtlpPort)),
-		)
+		// NOTE: endpoint urls explicitly set the scheme, so that transport security doesn't depend on the environment
+		opts := []otlptracehttp.Option{otlptracehttp.WithEndpointURL(otlpHTTPEndpointURL(c, insecure, "/v1/traces"))}
+
+		if c.OtlpHTTPPath != "" {
+			opts = append(opts, otlptracehttp.WithURLPath(c.OtlpHTTPPath))
+		}
+
+		if c.OtlpTLSEnabled {
+			tlsConfig, err := newTLSConfig(c)
+			if err != nil {
+				return nil, fmt.Errorf("problem creating otlp tls configuration: %w", err)
+			}
+
+			opts = append(opts, otlptracehttp.WithTLSClientConfig(tlsConfig))
+		}
+
+		if headers := otlpHTTPHeaders(c); len(headers) > 0 {
+			opts = append(opts, otlptracehttp.WithHeaders(headers))
+		}
+
+		if c.OtlpTimeout > 0 {
+			opts = append(opts, otlptracehttp.WithTimeout(c.OtlpTimeout))
+		}
+
+		initialInterval, maxInterval, maxElapsedTime := otlpRetryIntervals(c)
+		opts = append(opts, otlptracehttp.WithRetry(otlptracehttp.RetryConfig{
+			Enabled:         !c.OtlpRetryDisabled,
+			InitialInterval: initialInterval,
+			MaxInterval:     maxInterval,
+			MaxElapsedTime:  maxElapsedTime,
+		}))
+
+		switch c.OtlpCompression {
+		case "", "gzip":
+			opts = append(opts, otlptracehttp.WithCompression(otlptracehttp.GzipCompression))
+		case "none":
+			opts = append(opts, otlptracehttp.WithCompression(otlptracehttp.NoCompression))
+		default:
+			return nil, fmt.Errorf("unsupported otlp compression: %s", c.OtlpCompression)
+		}
+
+		client = otlptracehttp.NewClient(opts...)
 	case "grpc":
-		exporter, err = otlptracegrpc.New(
-			context.Background(),
-			otlptracegrpc.WithEndpoint(fmt.Sprintf("%s:%d", c.OtlpHost, c.OtlpPort)),
-		)
+		endpoint := fmt.Sprintf("%s:%d", c.OtlpHost, otlpPort(c.OtlpEndpointKind, c.OtlpPort))
+
+		if c.OtlpEndpointURL != "" {
+			endpointURL, err := parseOtlpEndpointURLForKind(c.OtlpEndpointKind, c.OtlpEndpointURL)
+			if err != nil {
+				return nil, err
+			}
+
+			endpoint = endpointURL.Host
+		}
+
+		opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(endpoint)}
+
+		// NOTE: credentials are always set explicitly, so that transport security doesn't depend on the environment
+		transportCredentials := grpcinsecure.NewCredentials()
+
+		if insecure {
+			opts = append(opts, otlptracegrpc.WithInsecure())
+		} else {
+			tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+
+			if c.OtlpTLSEnabled {
+				if tlsConfig, err = newTLSConfig(c); err != nil {
+					return nil, fmt.Errorf("problem creating otlp tls configuration: %w", err)
+				}
+			}
+
+			transportCredentials = credentials.NewTLS(tlsConfig)
+			opts = append(opts, otlptracegrpc.WithTLSCredentials(transportCredentials))
+		}
+
+		// NOTE: dial options replace the exporter's default dial options, including the default user-agent
+		if c.OtlpUserAgent != "" || len(c.OtlpGRPCDialOptions) > 0 {
+			dialOptions := append([]grpc.DialOption{grpc.WithUserAgent(otlpUserAgent(c))}, c.OtlpGRPCDialOptions...)
+			opts = append(opts, otlptracegrpc.WithDialOption(dialOptions...))
+		}
+
+		if c.OtlpConnectBlocking {
+			if err = waitForOtlpGRPCConnection(ctx, c, endpoint, transportCredentials); err != nil {
+				return nil, err
+			}
+		}
+
+		if len(c.OtlpHeaders) > 0 {
+			opts = append(opts, otlptracegrpc.WithHeaders(c.OtlpHeaders))
+		}
+
+		if c.OtlpTimeout > 0 {
+			opts = append(opts, otlptracegrpc.WithTimeout(c.OtlpTimeout))
+		}
+
+		initialInterval, maxInterval, maxElapsedTime := otlpRetryIntervals(c)
+		opts = append(opts, otlptracegrpc.WithRetry(otlptracegrpc.RetryConfig{
+			Enabled:         !c.OtlpRetryDisabled,
+			InitialInterval: initialInterval,
+			MaxInterval:     maxInterval,
+			MaxElapsedTime:  maxElapsedTime,
+		}))
+
+		switch c.OtlpCompression {
+		case "", "gzip":
+			opts = append(opts, otlptracegrpc.WithCompressor("gzip"))
+		case "none":
+			// NOTE: grpc export requests are uncompressed unless a compressor is set
+		default:
+			return nil, fmt.Errorf("unsupported otlp compression: %s", c.OtlpCompression)
+		}
+
+		client = otlptracegrpc.NewClient(opts...)
 	default:
 		return nil, fmt.Errorf("unsupported otlp endpoint kind: %s", c.OtlpEndpointKind)
 	}
 
-	if err != nil {
-		return nil, fmt.Errorf("problem creating otlp exporter: %w", err)
-	}
-
-	return exporter, nil
+	return client, nil
 }
@@ -0,0 +1,139 @@
+package bobotel
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// newTestSpan returns a read-only span with the given trace ID, where the lower half of the trace ID selects the span
+// for trace ID ratios, and the given duration.
+func newTestSpan(lowerTraceID byte, duration time.Duration) sdktrace.ReadOnlySpan {
+	traceID := trace.TraceID{0x01, 8: lowerTraceID}
+	startTime := time.Unix(0, 0)
+
+	return tracetest.SpanStub{
+		Name: "span",
+		SpanContext: trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID: traceID, SpanID: trace.SpanID{0x01}, TraceFlags: trace.FlagsSampled,
+		}),
+		StartTime: startTime,
+		EndTime:   startTime.Add(duration),
+	}.Snapshot()
+}
+
+func TestNewTraceIDRatioExporter(t *testing.T) {
+	tests := []struct {
+		name     string
+		ratio    float64
+		expected int
+	}{
+		{name: "ratio of 0 drops every span", ratio: 0, expected: 0},
+		{name: "ratio of 0.5 keeps traces within the ratio", ratio: 0.5, expected: 2},
+		{name: "ratio of 1 keeps every span", ratio: 1, expected: 4},
+	}
+
+	spans := []sdktrace.ReadOnlySpan{
+		newTestSpan(0x00, time.Second),
+		newTestSpan(0x10, time.Second),
+		newTestSpan(0xa0, time.Second),
+		newTestSpan(0xff, time.Second),
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			memoryExporter := NewInMemoryExporter()
+			exporter := newTraceIDRatioExporter(memoryExporter, tt.ratio)
+
+			if err := exporter.ExportSpans(context.Background(), spans); err != nil {
+				t.Fatalf("ExportSpans() error = %v", err)
+			}
+
+			if exported := len(memoryExporter.GetRecordedSpans()); exported != tt.expected {
+				t.Errorf("exported %d spans, expected %d", exported, tt.expected)
+			}
+		})
+	}
+}
+
+func TestNewTraceIDRatioExporterKeepsWholeTraces(t *testing.T) {
+	memoryExporter := NewInMemoryExporter()
+	exporter := newTraceIDRatioExporter(memoryExporter, 0.5)
+
+	spans := []sdktrace.ReadOnlySpan{newTestSpan(0x10, time.Second), newTestSpan(0x10, 2*time.Second)}
+
+	if err := exporter.ExportSpans(context.Background(), spans); err != nil {
+		t.Fatalf("ExportSpans() error = %v", err)
+	}
+
+	if exported := len(memoryExporter.GetRecordedSpans()); exported != len(spans) {
+		t.Errorf("exported %d spans of the same trace, expected %d", exported, len(spans))
+	}
+}
+
+func TestParseOtlpSampleRatio(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    string
+		expected float64
+		wantErr  bool
+	}{
+		{name: "unset exports every span", value: "", expected: 1},
+		{name: "zero", value: "0", expected: 0},
+		{name: "ratio", value: "0.25", expected: 0.25},
+		{name: "one", value: "1.0", expected: 1},
+		{name: "negative", value: "-0.1", wantErr: true},
+		{name: "greater than one", value: "1.5", wantErr: true},
+		{name: "not a number", value: "half", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ratio, err := parseOtlpSampleRatio(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseOtlpSampleRatio() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if ratio != tt.expected {
+				t.Errorf("parseOtlpSampleRatio() = %v, expected %v", ratio, tt.expected)
+			}
+		})
+	}
+}
+
+func TestNewMinDurationExporter(t *testing.T) {
+	tests := []struct {
+		name        string
+		minDuration time.Duration
+		expected    int
+	}{
+		{name: "unset keeps every span", minDuration: 0, expected: 3},
+		{name: "drops shorter spans", minDuration: time.Second, expected: 2},
+		{name: "drops every span", minDuration: time.Hour, expected: 0},
+	}
+
+	spans := []sdktrace.ReadOnlySpan{
+		newTestSpan(0x00, time.Millisecond),
+		newTestSpan(0x00, time.Second),
+		newTestSpan(0x00, time.Minute),
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			memoryExporter := NewInMemoryExporter()
+			exporter := newMinDurationExporter(memoryExporter, tt.minDuration)
+
+			if err := exporter.ExportSpans(context.Background(), spans); err != nil {
+				t.Fatalf("ExportSpans() error = %v", err)
+			}
+
+			if exported := len(memoryExporter.GetRecordedSpans()); exported != tt.expected {
+				t.Errorf("exported %d spans, expected %d", exported, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,199 @@
+package bobotel
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// Validate checks the Config using the same validation as the bconf field-sets, which allows a Config that is
+// constructed directly rather than loaded via bconf to be validated. Unset values that fall back to a default when
+// initializing a trace provider (e.g. an empty sampler or span processor) are accepted. Otlp, file, and zipkin
+// fields are only validated when the corresponding exporter is configured.
+func (c *Config) Validate() error {
+	errs := []error{}
+
+	validate := func(fieldSetKey, fieldKey string, validator func(v any) error, value any) {
+		if err := validator(value); err != nil {
+			errs = append(errs, fmt.Errorf("invalid '%s.%s' value: %w", fieldSetKey, fieldKey, err))
+		}
+	}
+
+	validateEnumeration := func(fieldSetKey, fieldKey, value string, acceptedValues ...string) {
+		if value != "" && !slices.Contains(acceptedValues, value) {
+			errs = append(errs, fmt.Errorf(
+				"invalid '%s.%s' value: '%s', expected one of %v", fieldSetKey, fieldKey, value, acceptedValues,
+			))
+		}
+	}
+
+	samplers := []string{"always_on", "always_off", "traceidratio", "parentbased_always_on", "parentbased_traceidratio"}
+	parentSamplers := []string{"always_on", "always_off", "traceidratio"}
+
+	validate(OtelFieldSetKey, OtelExportersKey, otelExportersValidator, c.OtelExporters)
+	validateEnumeration(OtelFieldSetKey, OtelFallbackExporterKey, c.OtelFallbackExporter, "console")
+	validateEnumeration(
+		OtelFieldSetKey, OtelConsoleFormatKey, c.OtelConsoleFormat, "production", "json", "pretty", "logfmt",
+	)
+	validateEnumeration(OtelFieldSetKey, OtelConsoleOutputKey, c.OtelConsoleOutput, "stdout", "stderr")
+	validate(OtelFieldSetKey, OtelConsoleMinDurationKey, nonNegativeDurationValidator, c.OtelConsoleMinDuration)
+	validate(OtelFieldSetKey, OtelResourceDetectorsKey, otelResourceDetectorsValidator, c.OtelResourceDetectors)
+	validateEnumeration(OtelFieldSetKey, OtelSamplerKey, c.OtelSampler, samplers...)
+	validate(OtelFieldSetKey, OtelSamplerRatioKey, otelSamplerRatioValidator, c.OtelSamplerRatio)
+	validateEnumeration(
+		OtelFieldSetKey, OtelSamplerRemoteParentSampledKey, c.OtelSamplerRemoteParentSampled, parentSamplers...,
+	)
+	validateEnumeration(
+		OtelFieldSetKey, OtelSamplerRemoteParentNotSampledKey, c.OtelSamplerRemoteParentNotSampled, parentSamplers...,
+	)
+	validateEnumeration(
+		OtelFieldSetKey, OtelSamplerLocalParentSampledKey, c.OtelSamplerLocalParentSampled, parentSamplers...,
+	)
+	validateEnumeration(
+		OtelFieldSetKey, OtelSamplerLocalParentNotSampledKey, c.OtelSamplerLocalParentNotSampled, parentSamplers...,
+	)
+	for idx, rule := range c.OtelSamplerRules {
+		if err := otelSamplerRatioValidator(rule.Ratio); err != nil {
+			errs = append(errs, fmt.Errorf("invalid sampler rule %d ratio: %w", idx, err))
+		}
+	}
+
+	validate(OtelFieldSetKey, OtelPropagatorsKey, otelPropagatorsValidator, c.OtelPropagators)
+	validate(OtelFieldSetKey, OtelMaxBaggageBytesKey, nonNegativeIntValidator, c.OtelMaxBaggageBytes)
+	validateEnumeration(OtelFieldSetKey, OtelIDFormatKey, c.OtelIDFormat, "random", "xray")
+	validateEnumeration(OtelFieldSetKey, OtelSpanProcessorKey, c.OtelSpanProcessor, "batch", "simple")
+	validate(OtelFieldSetKey, OtelExporterSpanProcessorsKey, otelExporterSpanProcessorsValidator,
+		c.OtelExporterSpanProcessors)
+	validate(OtelFieldSetKey, OtelBatchTimeoutKey, nonNegativeDurationValidator, c.OtelBatchTimeout)
+	validate(OtelFieldSetKey, OtelExportTimeoutKey, nonNegativeDurationValidator, c.OtelExportTimeout)
+	validate(OtelFieldSetKey, OtelMaxExportBatchSizeKey, nonNegativeIntValidator, c.OtelMaxExportBatchSize)
+	validate(OtelFieldSetKey, OtelMaxQueueSizeKey, nonNegativeIntValidator, c.OtelMaxQueueSize)
+	validate(OtelFieldSetKey, OtelSlowSpanThresholdKey, nonNegativeDurationValidator, c.OtelSlowSpanThreshold)
+	validate(
+		OtelFieldSetKey, OtelPeriodicFlushIntervalKey, nonNegativeDurationValidator, c.OtelPeriodicFlushInterval,
+	)
+
+	validate(SpanLimitsFieldSetKey, SpanLimitsMaxAttributesPerSpanKey, nonNegativeIntValidator,
+		c.SpanLimitsMaxAttributesPerSpan)
+	validate(SpanLimitsFieldSetKey, SpanLimitsMaxEventsPerSpanKey, nonNegativeIntValidator,
+		c.SpanLimitsMaxEventsPerSpan)
+	validate(SpanLimitsFieldSetKey, SpanLimitsMaxLinksPerSpanKey, nonNegativeIntValidator,
+		c.SpanLimitsMaxLinksPerSpan)
+	validate(SpanLimitsFieldSetKey, SpanLimitsMaxAttributeValueLengthKey, nonNegativeIntValidator,
+		c.SpanLimitsMaxAttributeValueLength)
+
+	if slices.Contains(c.OtelExporters, "file") {
+		if c.OtelFilePath == "" {
+			errs = append(errs, fmt.Errorf("missing '%s.%s' value", OtelFieldSetKey, OtelFilePathKey))
+		}
+
+		validate(OtelFieldSetKey, OtelFileMaxSizeKey, nonNegativeIntValidator, c.OtelFileMaxSize)
+	}
+
+	if slices.Contains(c.OtelExporters, "otlp") {
+		validateEnumeration(OtlpFieldSetKey, OtlpEndpointKindKey, c.OtlpEndpointKind, "http", "grpc")
+
+		if c.OtlpEndpointKind == "" {
+			errs = append(errs, fmt.Errorf("missing '%s.%s' value", OtlpFieldSetKey, OtlpEndpointKindKey))
+		}
+
+		if c.OtlpEndpointURL != "" {
+			validate(OtlpFieldSetKey, OtlpEndpointURLKey, otlpEndpointURLValidator, c.OtlpEndpointURL)
+		} else if c.OtlpHost != "" || otlpEnvEndpointURL("") == "" {
+			if c.OtlpHost == "" {
+				errs = append(errs, fmt.Errorf("missing '%s.%s' value", OtlpFieldSetKey, OtlpHostKey))
+			}
+
+			// NOTE: an unset port defaults to the default port of the endpoint kind
+			if c.OtlpPort != 0 {
+				validate(OtlpFieldSetKey, OtlpPortKey, otlpPortValidator, c.OtlpPort)
+			}
+		}
+
+		validate(OtlpFieldSetKey, OtlpHTTPPathKey, otlpHTTPPathValidator, c.OtlpHTTPPath)
+		validateEnumeration(OtlpFieldSetKey, OtlpHTTPEncodingKey, c.OtlpHTTPEncoding, "protobuf", "json")
+
+		if c.OtlpHTTPEncoding == "json" && c.OtlpEndpointKind != "http" {
+			errs = append(errs, fmt.Errorf(
+				"invalid '%s.%s' value: 'json' is only supported by the 'http' endpoint kind",
+				OtlpFieldSetKey, OtlpHTTPEncodingKey,
+			))
+		}
+
+		validateEnumeration(OtlpFieldSetKey, OtlpCompressionKey, c.OtlpCompression, "none", "gzip")
+		validate(OtlpFieldSetKey, OtlpSampleRatioKey, otlpSampleRatioValidator, c.OtlpSampleRatio)
+		validate(OtlpFieldSetKey, OtlpTimeoutKey, nonNegativeDurationValidator, c.OtlpTimeout)
+		validate(OtlpFieldSetKey, OtlpRetryInitialIntervalKey, nonNegativeDurationValidator,
+			c.OtlpRetryInitialInterval)
+		validate(OtlpFieldSetKey, OtlpRetryMaxIntervalKey, nonNegativeDurationValidator, c.OtlpRetryMaxInterval)
+		validate(OtlpFieldSetKey, OtlpRetryMaxElapsedTimeKey, nonNegativeDurationValidator,
+			c.OtlpRetryMaxElapsedTime)
+	}
+
+	if slices.Contains(c.OtelExporters, "zipkin") {
+		validate(ZipkinFieldSetKey, ZipkinCollectorURLKey, zipkinCollectorURLValidator, c.ZipkinCollectorURL)
+	}
+
+	return errors.Join(errs...)
+}
+
+// ValidateConfig performs a dry run of trace provider initialization for the given Config, e.g. for gating deploys on
+// configuration correctness in CI. In addition to Validate, the sampler and propagators are created, TLS certificates
+// are read, the directory of the 'file' exporter path is checked, and the otlp and zipkin exporters are created and
+// shut down without connecting to their endpoints. Exporters registered via RegisterExporter are not created.
+func ValidateConfig(c *Config) error {
+	if c == nil {
+		return errors.New("no trace provider configuration provided")
+	}
+
+	if err := c.Validate(); err != nil {
+		return err
+	}
+
+	errs := []error{}
+
+	if _, _, err := newSampler(c); err != nil {
+		errs = append(errs, fmt.Errorf("problem creating tracer provider sampler: %w", err))
+	}
+
+	if _, err := newPropagator(c); err != nil {
+		errs = append(errs, fmt.Errorf("problem creating tracer provider propagators: %w", err))
+	}
+
+	if slices.Contains(c.OtelExporters, "file") {
+		if _, err := os.Stat(filepath.Dir(c.OtelFilePath)); err != nil {
+			errs = append(errs, fmt.Errorf("problem accessing file exporter directory: %w", err))
+		}
+	}
+
+	for _, endpointConfig := range newOtlpEndpointConfigs(c) {
+		// NOTE: the exporters connect lazily unless connect blocking is enabled
+		dryRunConfig := *endpointConfig
+		dryRunConfig.OtlpConnectBlocking = false
+
+		if err := dryRunExporter(newOtlpExporter(context.Background(), &dryRunConfig)); err != nil {
+			errs = append(errs, fmt.Errorf("problem creating tracer otlp exporter: %w", err))
+		}
+	}
+
+	if slices.Contains(c.OtelExporters, "zipkin") {
+		if err := dryRunExporter(newZipkinExporter(c)); err != nil {
+			errs = append(errs, fmt.Errorf("problem creating tracer zipkin exporter: %w", err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func dryRunExporter(exporter sdktrace.SpanExporter, err error) error {
+	if err != nil {
+		return err
+	}
+
+	return exporter.Shutdown(context.Background())
+}
@@ -0,0 +1,85 @@
+package bobotel
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestValidateConfig(t *testing.T) {
+	t.Setenv("OTEL_EXPORTER_OTLP_TRACES_ENDPOINT", "")
+	t.Setenv("OTEL_EXPORTER_OTLP_ENDPOINT", "")
+
+	missingPath := filepath.Join(t.TempDir(), "missing")
+
+	tests := []struct {
+		name    string
+		config  *Config
+		wantErr bool
+	}{
+		{
+			name:    "nil config",
+			wantErr: true,
+		},
+		{
+			name:   "console exporter",
+			config: &Config{OtelExporters: []string{"console"}},
+		},
+		{
+			name:   "otlp exporter",
+			config: &Config{OtelExporters: []string{"otlp"}, OtlpEndpointKind: "http", OtlpHost: "collector"},
+		},
+		{
+			name:    "unsupported exporter",
+			config:  &Config{OtelExporters: []string{"unsupported"}},
+			wantErr: true,
+		},
+		{
+			name:    "unsupported sampler",
+			config:  &Config{OtelExporters: []string{"console"}, OtelSampler: "sometimes"},
+			wantErr: true,
+		},
+		{
+			name:    "missing otlp host",
+			config:  &Config{OtelExporters: []string{"otlp"}, OtlpEndpointKind: "http"},
+			wantErr: true,
+		},
+		{
+			name: "json encoding with grpc endpoint kind",
+			config: &Config{
+				OtelExporters: []string{"otlp"}, OtlpEndpointKind: "grpc", OtlpHost: "collector",
+				OtlpHTTPEncoding: "json",
+			},
+			wantErr: true,
+		},
+		{
+			name: "missing ca cert",
+			config: &Config{
+				OtelExporters: []string{"otlp"}, OtlpEndpointKind: "http", OtlpHost: "collector",
+				OtlpTLSEnabled: true, OtlpCACertPath: filepath.Join(missingPath, "ca.pem"),
+			},
+			wantErr: true,
+		},
+		{
+			name:   "file exporter",
+			config: &Config{OtelExporters: []string{"file"}, OtelFilePath: filepath.Join(t.TempDir(), "traces.jsonl")},
+		},
+		{
+			name:    "missing file exporter directory",
+			config:  &Config{OtelExporters: []string{"file"}, OtelFilePath: filepath.Join(missingPath, "traces.jsonl")},
+			wantErr: true,
+		},
+		{
+			name:    "invalid zipkin collector url",
+			config:  &Config{OtelExporters: []string{"zipkin"}, ZipkinCollectorURL: "not a url"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidateConfig(tt.config); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateConfig() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,20 @@
+package bobotel
+
+import (
+	"fmt"
+
+	"go.opentelemetry.io/otel/exporters/zipkin"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+func newZipkinExporter(c *Config) (sdktrace.SpanExporter, error) {
+	if c.ZipkinCollectorURL == "" {
+		return nil, fmt.Errorf("no collector url provided for zipkin exporter")
+	}
+
+	if err := zipkinCollectorURLValidator(c.ZipkinCollectorURL); err != nil {
+		return nil, err
+	}
+
+	return zipkin.New(c.ZipkinCollectorURL)
+}